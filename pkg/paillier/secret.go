@@ -4,6 +4,7 @@ import (
 	"crypto/rand"
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/cronokirby/safenum"
 	"github.com/taurusgroup/multi-party-sig/internal/params"
@@ -54,17 +55,38 @@ func (sk *SecretKey) Phi() *safenum.Nat {
 	return sk.phi
 }
 
+// Option configures the randomness source used by the generation functions in this package.
+type Option func(*options)
+
+type options struct {
+	rand io.Reader
+}
+
+// WithRand overrides the default crypto/rand.Reader randomness source — e.g. for deterministic
+// test vectors, an HSM-backed DRBG, or recorded-entropy reproductions.
+func WithRand(rand io.Reader) Option {
+	return func(o *options) { o.rand = rand }
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{rand: rand.Reader}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
 // KeyGen generates a new PublicKey and it's associated SecretKey.
-func KeyGen(pl *pool.Pool) (pk *PublicKey, sk *SecretKey) {
-	sk = NewSecretKey(pl)
+func KeyGen(pl *pool.Pool, opts ...Option) (pk *PublicKey, sk *SecretKey) {
+	sk = NewSecretKey(pl, opts...)
 	pk = sk.PublicKey
 	return
 }
 
 // NewSecretKey generates primes p and q suitable for the scheme, and returns the initialized SecretKey.
-func NewSecretKey(pl *pool.Pool) *SecretKey {
-	// TODO maybe we could take the reader as argument?
-	return NewSecretKeyFromPrimes(sample.Paillier(rand.Reader, pl))
+func NewSecretKey(pl *pool.Pool, opts ...Option) *SecretKey {
+	o := newOptions(opts)
+	return NewSecretKeyFromPrimes(sample.Paillier(o.rand, pl))
 }
 
 // NewSecretKeyFromPrimes generates a new SecretKey. Assumes that P and Q are prime.
@@ -117,8 +139,9 @@ func (sk *SecretKey) Dec(ct *Ciphertext) (*safenum.Int, error) {
 	return new(safenum.Int).SetModSymmetric(result, n), nil
 }
 
-func (sk SecretKey) GeneratePedersen() (*pedersen.Parameters, *safenum.Nat) {
-	s, t, lambda := sample.Pedersen(rand.Reader, sk.phi, sk.PublicKey.n)
+func (sk SecretKey) GeneratePedersen(opts ...Option) (*pedersen.Parameters, *safenum.Nat) {
+	o := newOptions(opts)
+	s, t, lambda := sample.Pedersen(o.rand, sk.phi, sk.PublicKey.n)
 	ped := pedersen.New(sk.PublicKey.n, s, t)
 	ped.SetCRT(sk.nCRT)
 	return ped, lambda
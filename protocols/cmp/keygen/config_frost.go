@@ -0,0 +1,19 @@
+package keygen
+
+import (
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/party"
+)
+
+// PartyID returns our own party ID.
+//
+// This, together with SecretECDSA and PublicECDSA, lets Config satisfy the small frost.Config
+// interface so that protocols/frost can sign on top of a regular CMP key, alongside the
+// lighter-weight keys produced by protocols/simplpedpop.
+func (c *Config) PartyID() party.ID { return c.ID }
+
+// SecretECDSA returns our own secret key share xᵢ.
+func (c *Config) SecretECDSA() *curve.Scalar { return c.ECDSA }
+
+// PublicECDSA returns the public key share Xⱼ of party j.
+func (c *Config) PublicECDSA(j party.ID) *curve.Point { return c.Public[j].ECDSA }
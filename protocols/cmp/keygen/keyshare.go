@@ -0,0 +1,74 @@
+package keygen
+
+import (
+	"errors"
+
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/polynomial"
+	"github.com/taurusgroup/multi-party-sig/pkg/party"
+)
+
+// ThresholdKeyShare is Config, named for the role it plays once a protocol needs to run Lagrange
+// interpolation against a chosen signing subset — as opposed to a KeyShare, which has already been
+// scoped to one fixed subset and needs no further interpolation.
+type ThresholdKeyShare = Config
+
+// KeyShare is a ThresholdKeyShare that has been scaled to one fixed signing subset: our own share
+// λᵢ·xᵢ, and every signer's public share λⱼ·Xⱼ. sign.StartSign computes exactly this on every
+// call; callers that repeatedly sign with the same subset can compute it once with
+// Config.KeyShare and reuse it, instead of re-running Lagrange interpolation each session.
+type KeyShare struct {
+	Threshold int
+	// Public[j] = λⱼ·Xⱼ, for every j in the signing subset.
+	Public map[party.ID]*curve.Point
+	// PublicKey = Y, the aggregated group public key.
+	PublicKey *curve.Point
+	ID        party.ID
+	// ECDSA = λᵢ·xᵢ, our own scaled secret share.
+	ECDSA *curve.Scalar
+}
+
+// ToAdditiveShares returns {λᵢ·xᵢ}, keyed by our own party ID, for the Lagrange coefficient λᵢ
+// that signers induces. This is the same quantity sign.StartSign obtains through Config.KeyShare
+// when it scales the config's secret share down to an additive one for a signing session; this method
+// exposes it so other protocols (such as protocols/cmp/reshare) can reuse it directly.
+//
+// Only our own entry is populated, since a Config only ever holds its own secret share.
+func (c *Config) ToAdditiveShares(signers []party.ID) (map[party.ID]*curve.Scalar, error) {
+	signerIDs := party.NewIDSlice(signers)
+	if !c.CanSign(signerIDs) {
+		return nil, errors.New("keygen: signers is not a valid signing subset")
+	}
+
+	lagrange := polynomial.Lagrange(signers)
+	additive := curve.NewScalar().Multiply(lagrange[c.ID], c.ECDSA)
+	return map[party.ID]*curve.Scalar{c.ID: additive}, nil
+}
+
+// KeyShare scales this Config down to the fixed signing subset signers, returning our own
+// Lagrange-weighted secret share together with every signer's scaled public share.
+func (c *Config) KeyShare(signers []party.ID) (*KeyShare, error) {
+	signerIDs := party.NewIDSlice(signers)
+	if !c.CanSign(signerIDs) {
+		return nil, errors.New("keygen: signers is not a valid signing subset")
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	lagrange := polynomial.Lagrange(signers)
+	Public := make(map[party.ID]*curve.Point, len(signerIDs))
+	PublicKey := curve.NewIdentityPoint()
+	for _, j := range signerIDs {
+		Public[j] = curve.NewIdentityPoint().ScalarMult(lagrange[j], c.Public[j].ECDSA)
+		PublicKey.Add(PublicKey, Public[j])
+	}
+
+	return &KeyShare{
+		Threshold: int(c.Threshold),
+		Public:    Public,
+		PublicKey: PublicKey,
+		ID:        c.ID,
+		ECDSA:     curve.NewScalar().Multiply(lagrange[c.ID], c.ECDSA),
+	}, nil
+}
@@ -0,0 +1,204 @@
+package keygen
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+
+	"github.com/cronokirby/safenum"
+	"github.com/taurusgroup/multi-party-sig/internal/hash"
+	"github.com/taurusgroup/multi-party-sig/internal/round"
+	"github.com/taurusgroup/multi-party-sig/internal/types"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/polynomial"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/sample"
+	"github.com/taurusgroup/multi-party-sig/pkg/paillier"
+	"github.com/taurusgroup/multi-party-sig/pkg/party"
+	"github.com/taurusgroup/multi-party-sig/pkg/pedersen"
+	"github.com/taurusgroup/multi-party-sig/pkg/pool"
+	"github.com/taurusgroup/multi-party-sig/pkg/protocol"
+	prototypes "github.com/taurusgroup/multi-party-sig/pkg/protocol/types"
+	zksch "github.com/taurusgroup/multi-party-sig/pkg/zk/sch"
+)
+
+const (
+	protocolID     prototypes.ProtocolID  = "cmp/keygen"
+	protocolRounds prototypes.RoundNumber = 5
+)
+
+// ridByteLen is the byte length of a RID or ChainKey contribution.
+const ridByteLen = 32
+
+// Option configures the randomness source used by StartKeygen, independently of the *pool.Pool
+// used to parallelize the CPU-bound parts of Paillier/Pedersen generation.
+type Option func(*options)
+
+type options struct {
+	rand io.Reader
+}
+
+// WithRand overrides the default crypto/rand.Reader randomness source for every value StartKeygen
+// samples — the ElGamal secret, our VSS polynomial, our RID and ChainKey contributions, our
+// Paillier and Pedersen parameters, and our Schnorr proof-of-knowledge randomness — e.g. for
+// deterministic test vectors, an HSM-backed DRBG, or recorded-entropy reproductions.
+func WithRand(rand io.Reader) Option {
+	return func(o *options) { o.rand = rand }
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{rand: rand.Reader}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+type round1 struct {
+	*round.Helper
+
+	Threshold int
+
+	// xPoly = fᵢ(X), our own degree-Threshold VSS polynomial.
+	xPoly *polynomial.Polynomial
+	XSelf *polynomial.Exponent
+
+	RIDSelf      types.RID
+	ChainKeySelf types.RID
+
+	ElGamalSecretSelf curve.Scalar
+	ElGamalPublicSelf curve.Point
+
+	PaillierSecret *paillier.SecretKey
+	PedersenParams *pedersen.Parameters
+	PedersenSecret *safenum.Nat
+
+	SchnorrRand *zksch.Randomness
+
+	CommitmentSelf   hash.Commitment
+	DecommitmentSelf hash.Decommitment
+}
+
+// sampleRID reads a fresh RID-sized random contribution from rnd.
+func sampleRID(rnd io.Reader) (types.RID, error) {
+	rid := make(types.RID, ridByteLen)
+	if _, err := io.ReadFull(rnd, rid); err != nil {
+		return nil, fmt.Errorf("keygen: failed to sample RID: %w", err)
+	}
+	return rid, nil
+}
+
+// StartKeygen runs the first round of a fresh (or refreshed) Threshold-of-len(partyIDs) CMP
+// keygen among partyIDs. It generates this party's ElGamal key, Paillier key, Pedersen
+// parameters, VSS polynomial, RID and ChainKey contributions, and Schnorr proof-of-knowledge
+// randomness, and commits to all of it: round1.Finalize reveals only the commitment, and round2
+// reveals the committed values in the clear, the standard commit-then-reveal defense against a
+// party choosing their RID/ChainKey contribution after seeing anyone else's.
+func StartKeygen(pl *pool.Pool, selfID party.ID, partyIDs []party.ID, threshold int, opts ...Option) protocol.StartFunc {
+	return func() (round.Round, protocol.Info, error) {
+		ids := party.NewIDSlice(partyIDs)
+		if threshold < 1 || threshold >= len(ids) {
+			return nil, nil, fmt.Errorf("keygen.StartKeygen: invalid threshold %d for %d parties", threshold, len(ids))
+		}
+
+		helper, err := round.NewHelper(protocolID, protocolRounds, selfID, ids)
+		if err != nil {
+			return nil, nil, fmt.Errorf("keygen.StartKeygen: %w", err)
+		}
+
+		o := newOptions(opts)
+
+		elGamalSecret := sample.Scalar(o.rand)
+		elGamalPublic := curve.NewIdentityPoint().ScalarBaseMult(elGamalSecret)
+
+		paillierSecret := paillier.NewSecretKey(pl, paillier.WithRand(o.rand))
+		pedersenParams, pedersenSecret := paillierSecret.GeneratePedersen(paillier.WithRand(o.rand))
+
+		xPoly := polynomial.NewPolynomial(threshold, sample.Scalar(o.rand))
+		XSelf := polynomial.NewPolynomialExponent(xPoly)
+
+		rid, err := sampleRID(o.rand)
+		if err != nil {
+			return nil, nil, fmt.Errorf("keygen.StartKeygen: %w", err)
+		}
+		chainKey, err := sampleRID(o.rand)
+		if err != nil {
+			return nil, nil, fmt.Errorf("keygen.StartKeygen: %w", err)
+		}
+
+		schnorrRand := zksch.NewRandomness(o.rand)
+
+		decommitment := make(hash.Decommitment, ridByteLen)
+		if _, err := io.ReadFull(o.rand, decommitment); err != nil {
+			return nil, nil, fmt.Errorf("keygen.StartKeygen: %w", err)
+		}
+
+		h := helper.Hash()
+		if err := h.WriteAny(rid, chainKey, XSelf, schnorrRand.Commitment(), elGamalPublic,
+			paillierSecret.PublicKey.N(), pedersenParams.S(), pedersenParams.T(), decommitment); err != nil {
+			return nil, nil, fmt.Errorf("keygen.StartKeygen: %w", err)
+		}
+		commitment := make(hash.Commitment, ridByteLen)
+		if _, err := io.ReadFull(h, commitment); err != nil {
+			return nil, nil, fmt.Errorf("keygen.StartKeygen: %w", err)
+		}
+
+		return &round1{
+			Helper:            helper,
+			Threshold:         threshold,
+			xPoly:             xPoly,
+			XSelf:             XSelf,
+			RIDSelf:           rid,
+			ChainKeySelf:      chainKey,
+			ElGamalSecretSelf: *elGamalSecret,
+			ElGamalPublicSelf: *elGamalPublic,
+			PaillierSecret:    paillierSecret,
+			PedersenParams:    pedersenParams,
+			PedersenSecret:    pedersenSecret,
+			SchnorrRand:       schnorrRand,
+			CommitmentSelf:    commitment,
+			DecommitmentSelf:  decommitment,
+		}, helper, nil
+	}
+}
+
+// VerifyMessage implements round.Round. Nothing precedes round1, so there is nothing to verify.
+func (round1) VerifyMessage(round.Message) error { return nil }
+
+// StoreMessage implements round.Round. Nothing precedes round1, so there is nothing to store.
+func (round1) StoreMessage(round.Message) error { return nil }
+
+// Finalize implements round.Round.
+//
+//   - broadcast our commitment to this round's RID/ChainKey contribution, VSS polynomial,
+//     ElGamal key, Paillier/Pedersen parameters, and Schnorr proof-of-knowledge commitment.
+func (r *round1) Finalize(out chan<- *round.Message) (round.Round, error) {
+	if err := r.SendMessage(out, &message2{Commitment: r.CommitmentSelf}, ""); err != nil {
+		return r, err
+	}
+
+	selfID := r.SelfID()
+	return &round2{
+		round1:         r,
+		VSSPolynomials: map[party.ID]*polynomial.Exponent{selfID: r.XSelf},
+		Commitments:    map[party.ID]hash.Commitment{selfID: r.CommitmentSelf},
+		RIDs:           map[party.ID]types.RID{selfID: r.RIDSelf},
+		ChainKeys:      map[party.ID]types.RID{selfID: r.ChainKeySelf},
+		ShareReceived:  map[party.ID]curve.Scalar{},
+		ElGamalPublic:  map[party.ID]curve.Point{selfID: r.ElGamalPublicSelf},
+		PaillierPublic: map[party.ID]*paillier.PublicKey{selfID: r.PaillierSecret.PublicKey},
+		N:              map[party.ID]*safenum.Modulus{selfID: r.PaillierSecret.PublicKey.N()},
+		S:              map[party.ID]*safenum.Nat{selfID: r.PedersenParams.S()},
+		T:              map[party.ID]*safenum.Nat{selfID: r.PedersenParams.T()},
+		ElGamalSecret:  r.ElGamalSecretSelf,
+		PaillierSecret: r.PaillierSecret,
+		PedersenSecret: r.PedersenSecret,
+		SchnorrRand:    r.SchnorrRand,
+		Decommitment:   r.DecommitmentSelf,
+	}, nil
+}
+
+// MessageContent implements round.Round.
+func (round1) MessageContent() round.Content { return &message2{} }
+
+// Number implements round.Round.
+func (round1) Number() round.Number { return 1 }
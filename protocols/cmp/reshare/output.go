@@ -0,0 +1,131 @@
+package reshare
+
+import (
+	"fmt"
+
+	"github.com/taurusgroup/multi-party-sig/internal/round"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/polynomial"
+	"github.com/taurusgroup/multi-party-sig/pkg/party"
+	"github.com/taurusgroup/multi-party-sig/protocols/cmp/keygen"
+)
+
+type output struct {
+	*round1
+}
+
+// VerifyMessage implements round.Round.
+//
+//   - verify the Schnorr proof of knowledge of gᵢ(0) against λᵢ·Xᵢ, the dealer's original public
+//     share scaled by its Lagrange coefficient over OldSigners. This is what prevents a corrupt
+//     dealer from reconstituting a share of the wrong secret.
+func (r *output) VerifyMessage(msg round.Message) error {
+	body, ok := msg.Content.(*message1)
+	if !ok || body == nil || body.Contribution == nil || body.Proof == nil {
+		return round.ErrInvalidContent
+	}
+	expected := curve.NewIdentityPoint().ScalarMult(r.Lagrange[msg.From], r.OldPublicECDSA[msg.From])
+	if !body.Proof.Verify(r.HashForID(msg.From), expected) {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+// StoreMessage implements round.Round.
+//
+// Since the ciphertext is addressed only to us, the VSS check happens here.
+//   - decrypt our new share of dealer i's polynomial.
+//   - check that it matches the published contribution Gᵢ(k).
+func (r *output) StoreMessage(msg round.Message) error {
+	from, body := msg.From, msg.Content.(*message1)
+
+	share, err := decryptShare(r.IdentitySecret, r.IdentityPublic[from], transcriptAD(r.HashForID(from)), body.Ciphertext)
+	if err != nil {
+		return err
+	}
+
+	expected := body.Contribution.Evaluate(r.SelfID().Scalar())
+	actual := curve.NewIdentityPoint().ScalarBaseMult(share)
+	if !actual.Equal(expected) {
+		return ErrInvalidVSS
+	}
+
+	r.Contributions[from] = body.Contribution
+	r.SharesReceived[from] = share
+	r.RIDs[from] = body.RID
+	r.ChainKeys[from] = body.ChainKey
+	return nil
+}
+
+// Finalize implements round.Round.
+//
+//   - sum every dealer's contribution into the new public polynomial G(X) = Σᵢ Gᵢ(X), whose
+//     constant term is the same aggregated public key as before resharing.
+//   - recompute every new party's public share Xₖ' = G(k).
+//   - if we are one of NewParties, sum our share of every dealer's polynomial into our new secret
+//     share xₖ' = Σᵢ gᵢ(k).
+//   - XOR every dealer's RID and ChainKey contribution into the new Config's session parameters.
+func (r *output) Finalize(chan<- *round.Message) (round.Round, error) {
+	contributions := make([]*polynomial.Exponent, 0, len(r.OldSigners))
+	RID := make(keygen.RID, ridBytes)
+	ChainKey := make([]byte, ridBytes)
+	for _, i := range r.OldSigners {
+		contribution, ok := r.Contributions[i]
+		if !ok {
+			return nil, fmt.Errorf("reshare: missing contribution from dealer %v", i)
+		}
+		contributions = append(contributions, contribution)
+
+		rid, ok := r.RIDs[i]
+		if !ok {
+			return nil, fmt.Errorf("reshare: missing RID contribution from dealer %v", i)
+		}
+		chainKey, ok := r.ChainKeys[i]
+		if !ok {
+			return nil, fmt.Errorf("reshare: missing ChainKey contribution from dealer %v", i)
+		}
+		for b := range RID {
+			RID[b] ^= rid[b]
+		}
+		for b := range ChainKey {
+			ChainKey[b] ^= chainKey[b]
+		}
+	}
+	NewPublicPolynomial, err := polynomial.Sum(contributions)
+	if err != nil {
+		return nil, err
+	}
+
+	Public := make(map[party.ID]*keygen.Public, len(r.NewParties))
+	for _, k := range r.NewParties {
+		Public[k] = &keygen.Public{ECDSA: NewPublicPolynomial.Evaluate(k.Scalar())}
+	}
+
+	var secret *keygen.Secret
+	if containsID(r.NewParties, r.SelfID()) {
+		x := curve.NewScalar()
+		for _, i := range r.OldSigners {
+			x.Add(x, r.SharesReceived[i])
+		}
+		secret = &keygen.Secret{ID: r.SelfID(), ECDSA: x}
+	}
+
+	config := &keygen.Config{
+		Threshold: uint32(r.NewThreshold),
+		Public:    Public,
+		RID:       RID,
+		ChainKey:  ChainKey,
+		Secret:    secret,
+	}
+
+	return &round.Output{Result: &keygen.Result{Config: config}}, nil
+}
+
+// MessageContent implements round.Round.
+func (output) MessageContent() round.Content { return &message1{} }
+
+// Number implements round.Round.
+func (output) Number() round.Number { return 2 }
+
+// PreviousRound implements round.Round.
+func (r *output) PreviousRound() round.Round { return r.round1 }
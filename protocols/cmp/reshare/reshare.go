@@ -0,0 +1,25 @@
+// Package reshare implements threshold key resharing: redistributing an existing
+// protocols/cmp/keygen.Config, held under threshold t by party set P, into a new Config under a
+// possibly different threshold t' and party set P', while preserving the aggregated ECDSA public
+// key Y.
+//
+// Resharing works by having each party in a quorum of at least t+1 old signers treat its own
+// Lagrange-weighted additive share λᵢ·xᵢ (see Config.ToAdditiveShares) as the constant term of a
+// fresh degree-t' polynomial over the new party set, and distributing evaluations of that
+// polynomial the same way keygen distributes its own VSS shares. Since Σ λᵢ·xᵢ = x over any
+// qualifying subset, summing every dealer's contribution at a new party's index reconstructs that
+// party's share of the same secret x, without ever reconstructing x itself.
+//
+// This package only reshares the Shamir-level ECDSA secret. It does not mint new Paillier or
+// Pedersen parameters for incoming parties — a party joining the new set for the first time must
+// already have Paillier/Pedersen parameters of its own (e.g. from a prior keygen.Config it held,
+// or freshly generated with paillier.NewSecretKey) before the resulting Config can be used with
+// protocols/cmp/sign.
+package reshare
+
+import "github.com/taurusgroup/multi-party-sig/pkg/protocol/types"
+
+const (
+	protocolID     types.ProtocolID  = "cmp/reshare"
+	protocolRounds types.RoundNumber = 2
+)
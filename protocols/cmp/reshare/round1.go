@@ -0,0 +1,255 @@
+package reshare
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/taurusgroup/multi-party-sig/internal/hash"
+	"github.com/taurusgroup/multi-party-sig/internal/round"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/polynomial"
+	"github.com/taurusgroup/multi-party-sig/pkg/party"
+	"github.com/taurusgroup/multi-party-sig/pkg/protocol"
+	zksch "github.com/taurusgroup/multi-party-sig/pkg/zk/sch"
+	"github.com/taurusgroup/multi-party-sig/protocols/cmp/keygen"
+)
+
+var (
+	_ round.Round = (*round1)(nil)
+	_ round.Round = (*output)(nil)
+)
+
+var (
+	ErrInvalidProof = errors.New("reshare: Schnorr proof of knowledge is invalid")
+	ErrInvalidVSS   = errors.New("reshare: decrypted share does not match published contribution")
+)
+
+// ridBytes is the length, in bytes, of each dealer's fresh RID and ChainKey contribution.
+const ridBytes = 32
+
+type round1 struct {
+	*round.Helper
+
+	NewThreshold int
+	OldSigners   party.IDSlice
+	NewParties   party.IDSlice
+
+	// Lagrange[i], for i ranging over OldSigners.
+	Lagrange map[party.ID]*curve.Scalar
+	// OldPublicECDSA[i] = Xᵢ, dealer i's original, unscaled public ECDSA share.
+	OldPublicECDSA map[party.ID]*curve.Point
+
+	// IdentitySecret is our long-term ECDH secret, used to derive the per-pair AEAD key that
+	// protects the new shares we send out.
+	IdentitySecret *curve.Scalar
+	// IdentityPublic[j] is party j's long-term ECDH public key.
+	IdentityPublic map[party.ID]*curve.Point
+
+	// gPoly = gᵢ(X), our own fresh degree-NewThreshold polynomial. Nil unless we are a dealer,
+	// i.e. unless we are one of OldSigners.
+	gPoly *polynomial.Polynomial
+	// GSelf = Gᵢ(X) = gᵢ(X)·G, the public commitment to gPoly.
+	GSelf *polynomial.Exponent
+
+	// RID, ChainKey are our own fresh random contributions toward the new Config's session
+	// parameters. Nil unless we are a dealer.
+	RID      keygen.RID
+	ChainKey []byte
+
+	// Contributions[i] = Gᵢ(X), as published by dealer i. Seeded with our own, if we are a dealer.
+	Contributions map[party.ID]*polynomial.Exponent
+	// SharesReceived[i] = gᵢ(k), our own new share of dealer i's polynomial, if we are one of
+	// NewParties. Seeded with our own, if we are both a dealer and a recipient.
+	SharesReceived map[party.ID]*curve.Scalar
+	// RIDs[i], ChainKeys[i] = ridᵢ, cᵢ, dealer i's contribution toward the new RID = ⊕ᵢ ridᵢ and
+	// ChainKey = ⊕ᵢ cᵢ. Seeded with our own, if we are a dealer.
+	RIDs      map[party.ID]keygen.RID
+	ChainKeys map[party.ID][]byte
+}
+
+type message1 struct {
+	// Contribution = Gᵢ(X), the same for every recipient.
+	Contribution *polynomial.Exponent
+	// Proof is a Schnorr proof of knowledge of gᵢ(0), bound to λᵢ·Xᵢ. The same for every recipient.
+	Proof *zksch.Proof
+	// RID, ChainKey are the dealer's fresh contribution toward the new Config's session
+	// parameters, the same for every recipient.
+	RID      keygen.RID
+	ChainKey []byte
+	// Ciphertext = Enc(gᵢ(k)), addressed to this message's specific recipient.
+	Ciphertext []byte
+}
+
+// Start reshares oldConfig, held by OldSigners under its original threshold, into a new Config
+// held by NewParties under NewThreshold, preserving the aggregated public key. oldConfig is nil
+// unless selfID is one of oldSigners; it is only ever used to derive our own contribution as a
+// dealer. oldPublicECDSA must contain every old signer's original public ECDSA share Xᵢ, and is
+// required from every participant, whether or not they held a previous share themselves.
+// identityPublic must contain an entry for every party in oldSigners and newParties, including
+// selfID.
+func Start(
+	selfID party.ID,
+	oldConfig *keygen.Config,
+	oldSigners []party.ID,
+	oldPublicECDSA map[party.ID]*curve.Point,
+	newThreshold int,
+	newParties []party.ID,
+	identitySecret *curve.Scalar,
+	identityPublic map[party.ID]*curve.Point,
+) protocol.StartFunc {
+	return func() (round.Round, protocol.Info, error) {
+		oldSignerIDs := party.NewIDSlice(oldSigners)
+		newPartyIDs := party.NewIDSlice(newParties)
+		if newThreshold < 1 || newThreshold >= len(newPartyIDs) {
+			return nil, nil, fmt.Errorf("reshare.Start: invalid new threshold %d for %d parties", newThreshold, len(newPartyIDs))
+		}
+		for _, i := range oldSignerIDs {
+			if _, ok := oldPublicECDSA[i]; !ok {
+				return nil, nil, fmt.Errorf("reshare.Start: missing old public share for %v", i)
+			}
+		}
+
+		isDealer := containsID(oldSigners, selfID)
+		if isDealer {
+			if oldConfig == nil {
+				return nil, nil, errors.New("reshare.Start: selfID is an old signer but oldConfig is nil")
+			}
+			if oldConfig.ID != selfID {
+				return nil, nil, errors.New("reshare.Start: oldConfig does not belong to selfID")
+			}
+		}
+		isRecipient := containsID(newParties, selfID)
+
+		sessionParties := party.NewIDSlice(unionIDs(oldSigners, newParties))
+		for _, j := range sessionParties {
+			if _, ok := identityPublic[j]; !ok {
+				return nil, nil, fmt.Errorf("reshare.Start: missing identity key for %v", j)
+			}
+		}
+
+		helper, err := round.NewHelper(
+			protocolID,
+			protocolRounds,
+			selfID,
+			sessionParties,
+			hash.BytesWithDomain{TheDomain: "CMP Reshare Threshold", Bytes: []byte{byte(newThreshold)}},
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("reshare.Start: %w", err)
+		}
+
+		lagrange := polynomial.Lagrange(oldSigners)
+
+		r := &round1{
+			Helper:         helper,
+			NewThreshold:   newThreshold,
+			OldSigners:     oldSignerIDs,
+			NewParties:     newPartyIDs,
+			Lagrange:       lagrange,
+			OldPublicECDSA: oldPublicECDSA,
+			IdentitySecret: identitySecret,
+			IdentityPublic: identityPublic,
+			Contributions:  map[party.ID]*polynomial.Exponent{},
+			SharesReceived: map[party.ID]*curve.Scalar{},
+			RIDs:           map[party.ID]keygen.RID{},
+			ChainKeys:      map[party.ID][]byte{},
+		}
+
+		if isDealer {
+			additive, err := oldConfig.ToAdditiveShares(oldSigners)
+			if err != nil {
+				return nil, nil, fmt.Errorf("reshare.Start: %w", err)
+			}
+			r.gPoly = polynomial.NewPolynomial(newThreshold, additive[selfID])
+			r.GSelf = polynomial.NewPolynomialExponent(r.gPoly)
+			r.Contributions[selfID] = r.GSelf
+
+			r.RID = make(keygen.RID, ridBytes)
+			if _, err := io.ReadFull(rand.Reader, r.RID); err != nil {
+				return nil, nil, fmt.Errorf("reshare.Start: %w", err)
+			}
+			r.ChainKey = make([]byte, ridBytes)
+			if _, err := io.ReadFull(rand.Reader, r.ChainKey); err != nil {
+				return nil, nil, fmt.Errorf("reshare.Start: %w", err)
+			}
+			r.RIDs[selfID] = r.RID
+			r.ChainKeys[selfID] = r.ChainKey
+
+			if isRecipient {
+				r.SharesReceived[selfID] = r.gPoly.Evaluate(selfID.Scalar())
+			}
+		}
+
+		return r, helper, nil
+	}
+}
+
+// VerifyMessage implements round.Round. Nothing precedes round1, so there is nothing to verify.
+func (round1) VerifyMessage(round.Message) error { return nil }
+
+// StoreMessage implements round.Round. Nothing precedes round1, so there is nothing to store.
+func (round1) StoreMessage(round.Message) error { return nil }
+
+// Finalize implements round.Round.
+//
+//   - if we are a dealer, encrypt and send our new share of gᵢ(X), together with Gᵢ(X) and a proof
+//     of knowledge of gᵢ(0), to every new party.
+func (r *round1) Finalize(out chan<- *round.Message) (round.Round, error) {
+	if r.gPoly != nil {
+		expected := curve.NewIdentityPoint().ScalarMult(r.Lagrange[r.SelfID()], r.OldPublicECDSA[r.SelfID()])
+		proof := zksch.Prove(r.Hash(), expected, r.gPoly.Evaluate(curve.NewScalar()))
+
+		for _, k := range r.NewParties {
+			if k == r.SelfID() {
+				continue
+			}
+			share := r.gPoly.Evaluate(k.Scalar())
+			ciphertext, err := encryptShare(r.IdentitySecret, r.IdentityPublic[k], transcriptAD(r.Hash()), share)
+			if err != nil {
+				return r, err
+			}
+			if err := r.SendMessage(out, &message1{
+				Contribution: r.GSelf,
+				Proof:        proof,
+				RID:          r.RID,
+				ChainKey:     r.ChainKey,
+				Ciphertext:   ciphertext,
+			}, k); err != nil {
+				return r, err
+			}
+		}
+	}
+
+	return &output{round1: r}, nil
+}
+
+// MessageContent implements round.Round.
+func (round1) MessageContent() round.Content { return &message1{} }
+
+// Number implements round.Round.
+func (round1) Number() round.Number { return 1 }
+
+// Init implements round.Content.
+func (m *message1) Init(curve.Curve) {}
+
+func containsID(ids []party.ID, target party.ID) bool {
+	for _, id := range ids {
+		if id == target {
+			return true
+		}
+	}
+	return false
+}
+
+func unionIDs(a, b []party.ID) []party.ID {
+	union := make([]party.ID, 0, len(a)+len(b))
+	union = append(union, a...)
+	for _, id := range b {
+		if !containsID(a, id) {
+			union = append(union, id)
+		}
+	}
+	return union
+}
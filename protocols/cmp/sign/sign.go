@@ -6,8 +6,6 @@ import (
 
 	"github.com/taurusgroup/multi-party-sig/internal/hash"
 	"github.com/taurusgroup/multi-party-sig/internal/round"
-	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
-	"github.com/taurusgroup/multi-party-sig/pkg/math/polynomial"
 	"github.com/taurusgroup/multi-party-sig/pkg/paillier"
 	"github.com/taurusgroup/multi-party-sig/pkg/party"
 	"github.com/taurusgroup/multi-party-sig/pkg/pedersen"
@@ -68,26 +66,25 @@ func StartSign(pl *pool.Pool, config *keygen.Config, signers []party.ID, message
 			return nil, nil, fmt.Errorf("sign.Create: %w", err)
 		}
 
+		// Scale the config's secret and public ECDSA shares down to this signing subset.
+		keyShare, err := config.KeyShare(signers)
+		if err != nil {
+			return nil, nil, fmt.Errorf("sign.Create: %w", err)
+		}
+
 		// Scale public data
 		T := len(signerIDs)
-		ECDSA := make(map[party.ID]*curve.Point, T)
 		Paillier := make(map[party.ID]*paillier.PublicKey, T)
 		Pedersen := make(map[party.ID]*pedersen.Parameters, T)
-		PublicKey := curve.NewIdentityPoint()
-		lagrange := polynomial.Lagrange(signers)
 		for _, j := range signerIDs {
 			public := config.Public[j]
-			// scale public key share
-			ECDSA[j] = curve.NewIdentityPoint().ScalarMult(lagrange[j], public.ECDSA)
 			// create Paillier key
 			Paillier[j] = paillier.NewPublicKey(public.N)
 			// create Pedersen params
 			Pedersen[j] = pedersen.New(public.N, public.S, public.T)
-			PublicKey.Add(PublicKey, ECDSA[j])
 		}
 
 		// Scale own secret
-		SecretECDSA := curve.NewScalar().Multiply(lagrange[config.ID], config.ECDSA)
 		SecretPaillier := config.Paillier()
 		Paillier[selfID] = SecretPaillier.PublicKey
 		Pedersen[selfID].SetCRT(SecretPaillier.CRT())
@@ -95,12 +92,12 @@ func StartSign(pl *pool.Pool, config *keygen.Config, signers []party.ID, message
 		return &round1{
 			Helper:         helper,
 			Pool:           pl,
-			PublicKey:      PublicKey,
-			SecretECDSA:    SecretECDSA,
+			PublicKey:      keyShare.PublicKey,
+			SecretECDSA:    keyShare.ECDSA,
 			SecretPaillier: config.Paillier(),
 			Paillier:       Paillier,
 			Pedersen:       Pedersen,
-			ECDSA:          ECDSA,
+			ECDSA:          keyShare.Public,
 			Message:        message,
 		}, helper, nil
 	}
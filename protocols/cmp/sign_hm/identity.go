@@ -0,0 +1,180 @@
+package sign_hm
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"io"
+
+	"github.com/taurusgroup/multi-party-sig/internal/hash"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+)
+
+// sharedKey derives the symmetric AEAD key used to encrypt a share sent from us to peer, given our
+// long-term identity secret and peer's long-term identity public key. Both sides of the pair
+// arrive at the same key since ECDH is symmetric: ourSecret·peerPublic == peerSecret·ourPublic.
+func sharedKey(ourSecret *curve.Scalar, peerPublic *curve.Point) ([]byte, error) {
+	secretPoint := curve.NewIdentityPoint().ScalarMult(ourSecret, peerPublic)
+
+	h := hash.New()
+	if err := h.WriteAny(hash.BytesWithDomain{TheDomain: "CMP Sign HM Share Encryption"}, secretPoint); err != nil {
+		return nil, err
+	}
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(h, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// encryptShares encrypts kShare, gammaShare and xShare together under the AEAD key shared between
+// us and recipient, binding the ciphertext to associatedData (the session transcript hash) so that
+// a ciphertext from one signing session cannot be replayed into another.
+func encryptShares(ourSecret *curve.Scalar, peerPublic *curve.Point, associatedData []byte, kShare, gammaShare, xShare *curve.Scalar) ([]byte, error) {
+	key, err := sharedKey(ourSecret, peerPublic)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	kBytes, err := kShare.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	gammaBytes, err := gammaShare.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	xBytes, err := xShare.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	plaintext := append(append(kBytes, gammaBytes...), xBytes...)
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, associatedData), nil
+}
+
+// decryptShares reverses encryptShares.
+func decryptShares(ourSecret *curve.Scalar, peerPublic *curve.Point, associatedData []byte, ciphertext []byte) (kShare, gammaShare, xShare *curve.Scalar, err error) {
+	key, err := sharedKey(ourSecret, peerPublic)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, nil, nil, errors.New("sign_hm: ciphertext too short")
+	}
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+
+	plaintext, err := aead.Open(nil, nonce, sealed, associatedData)
+	if err != nil {
+		return nil, nil, nil, errors.New("sign_hm: failed to decrypt shares")
+	}
+	if len(plaintext) != 96 {
+		return nil, nil, nil, errors.New("sign_hm: unexpected plaintext length")
+	}
+
+	kShare = curve.NewScalar()
+	if err := kShare.UnmarshalBinary(plaintext[:32]); err != nil {
+		return nil, nil, nil, err
+	}
+	gammaShare = curve.NewScalar()
+	if err := gammaShare.UnmarshalBinary(plaintext[32:64]); err != nil {
+		return nil, nil, nil, err
+	}
+	xShare = curve.NewScalar()
+	if err := xShare.UnmarshalBinary(plaintext[64:]); err != nil {
+		return nil, nil, nil, err
+	}
+	return kShare, gammaShare, xShare, nil
+}
+
+// encryptProductShares encrypts deltaShare and sigmaShare together, the same way encryptShares
+// does for the round1 nonce shares.
+func encryptProductShares(ourSecret *curve.Scalar, peerPublic *curve.Point, associatedData []byte, deltaShare, sigmaShare *curve.Scalar) ([]byte, error) {
+	key, err := sharedKey(ourSecret, peerPublic)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	deltaBytes, err := deltaShare.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	sigmaBytes, err := sigmaShare.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	plaintext := append(deltaBytes, sigmaBytes...)
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, associatedData), nil
+}
+
+// decryptProductShares reverses encryptProductShares.
+func decryptProductShares(ourSecret *curve.Scalar, peerPublic *curve.Point, associatedData []byte, ciphertext []byte) (deltaShare, sigmaShare *curve.Scalar, err error) {
+	key, err := sharedKey(ourSecret, peerPublic)
+	if err != nil {
+		return nil, nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, nil, errors.New("sign_hm: ciphertext too short")
+	}
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+
+	plaintext, err := aead.Open(nil, nonce, sealed, associatedData)
+	if err != nil {
+		return nil, nil, errors.New("sign_hm: failed to decrypt shares")
+	}
+	if len(plaintext) != 64 {
+		return nil, nil, errors.New("sign_hm: unexpected plaintext length")
+	}
+
+	deltaShare = curve.NewScalar()
+	if err := deltaShare.UnmarshalBinary(plaintext[:32]); err != nil {
+		return nil, nil, err
+	}
+	sigmaShare = curve.NewScalar()
+	if err := sigmaShare.UnmarshalBinary(plaintext[32:]); err != nil {
+		return nil, nil, err
+	}
+	return deltaShare, sigmaShare, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// transcriptAD extracts a fixed-size digest out of h to use as AEAD associated data, binding an
+// encrypted share to everything already written to h (party identities, message, protocol ID).
+func transcriptAD(h *hash.Hash) []byte {
+	ad := make([]byte, 32)
+	_, _ = io.ReadFull(h, ad)
+	return ad
+}
@@ -0,0 +1,100 @@
+package sign_hm
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/taurusgroup/multi-party-sig/internal/hash"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/sample"
+)
+
+// scalarsEqual compares two scalars by their canonical encoding, since curve.Scalar does not
+// expose an Equal method the way curve.Point does.
+func scalarsEqual(t *testing.T, a, b *curve.Scalar) bool {
+	t.Helper()
+	aBytes, err := a.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+	bBytes, err := b.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+	return bytes.Equal(aBytes, bBytes)
+}
+
+// fixedAD returns the same 32-byte associated data transcriptAD would, for two independent
+// *hash.Hash instances seeded identically — standing in for what a sender and receiver who agree
+// on the session transcript would each derive.
+func fixedAD(domain string) []byte {
+	h := hash.New()
+	_ = h.WriteAny(hash.BytesWithDomain{TheDomain: domain})
+	return transcriptAD(h)
+}
+
+func TestEncryptDecryptShares_RoundTrip(t *testing.T) {
+	ourSecret := sample.Scalar(rand.Reader)
+	peerSecret := sample.Scalar(rand.Reader)
+	ourPublic := curve.NewIdentityPoint().ScalarBaseMult(ourSecret)
+	peerPublic := curve.NewIdentityPoint().ScalarBaseMult(peerSecret)
+
+	ad := fixedAD("test transcript")
+	kShare, gammaShare, xShare := sample.Scalar(rand.Reader), sample.Scalar(rand.Reader), sample.Scalar(rand.Reader)
+
+	ciphertext, err := encryptShares(ourSecret, peerPublic, ad, kShare, gammaShare, xShare)
+	if err != nil {
+		t.Fatalf("encryptShares: %v", err)
+	}
+
+	// The recipient derives the same AEAD key from peerSecret and ourPublic (ECDH is symmetric),
+	// and must use the same associated data the sender bound the ciphertext to.
+	gotK, gotGamma, gotX, err := decryptShares(peerSecret, ourPublic, ad, ciphertext)
+	if err != nil {
+		t.Fatalf("decryptShares: %v", err)
+	}
+	if !scalarsEqual(t, gotK, kShare) || !scalarsEqual(t, gotGamma, gammaShare) || !scalarsEqual(t, gotX, xShare) {
+		t.Fatal("decrypted shares do not match the shares that were encrypted")
+	}
+}
+
+func TestEncryptDecryptShares_ADMismatchFails(t *testing.T) {
+	ourSecret := sample.Scalar(rand.Reader)
+	peerSecret := sample.Scalar(rand.Reader)
+	ourPublic := curve.NewIdentityPoint().ScalarBaseMult(ourSecret)
+	peerPublic := curve.NewIdentityPoint().ScalarBaseMult(peerSecret)
+
+	kShare, gammaShare, xShare := sample.Scalar(rand.Reader), sample.Scalar(rand.Reader), sample.Scalar(rand.Reader)
+	ciphertext, err := encryptShares(ourSecret, peerPublic, fixedAD("sender transcript"), kShare, gammaShare, xShare)
+	if err != nil {
+		t.Fatalf("encryptShares: %v", err)
+	}
+
+	if _, _, _, err := decryptShares(peerSecret, ourPublic, fixedAD("a different transcript"), ciphertext); err == nil {
+		t.Fatal("decryptShares succeeded despite a mismatched associated data")
+	}
+}
+
+func TestEncryptDecryptProductShares_RoundTrip(t *testing.T) {
+	ourSecret := sample.Scalar(rand.Reader)
+	peerSecret := sample.Scalar(rand.Reader)
+	ourPublic := curve.NewIdentityPoint().ScalarBaseMult(ourSecret)
+	peerPublic := curve.NewIdentityPoint().ScalarBaseMult(peerSecret)
+
+	ad := fixedAD("test transcript")
+	deltaShare, sigmaShare := sample.Scalar(rand.Reader), sample.Scalar(rand.Reader)
+
+	ciphertext, err := encryptProductShares(ourSecret, peerPublic, ad, deltaShare, sigmaShare)
+	if err != nil {
+		t.Fatalf("encryptProductShares: %v", err)
+	}
+
+	gotDelta, gotSigma, err := decryptProductShares(peerSecret, ourPublic, ad, ciphertext)
+	if err != nil {
+		t.Fatalf("decryptProductShares: %v", err)
+	}
+	if !scalarsEqual(t, gotDelta, deltaShare) || !scalarsEqual(t, gotSigma, sigmaShare) {
+		t.Fatal("decrypted product shares do not match the shares that were encrypted")
+	}
+}
@@ -0,0 +1,72 @@
+package sign_hm
+
+import (
+	"github.com/taurusgroup/multi-party-sig/internal/round"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/polynomial"
+	"github.com/taurusgroup/multi-party-sig/pkg/party"
+)
+
+type output struct {
+	*round4
+
+	// Delta = δ = k·γ, reconstructed in round4.Finalize.
+	Delta *curve.Scalar
+	// SigmaShare is our final degree-t share of σ = γ·(m + r·x).
+	SigmaShare *curve.Scalar
+
+	// SigmaReceived[j] = σⱼ, as broadcast in the clear by signer j. Seeded with our own.
+	SigmaReceived map[party.ID]*curve.Scalar
+}
+
+type message4 struct {
+	// Sigma = σⱼ, this signer's share of σ = γ·(m + r·x), revealed in the clear.
+	Sigma *curve.Scalar
+}
+
+// VerifyMessage implements round.Round.
+func (output) VerifyMessage(msg round.Message) error {
+	body, ok := msg.Content.(*message4)
+	if !ok || body == nil || body.Sigma == nil {
+		return round.ErrInvalidContent
+	}
+	return nil
+}
+
+// StoreMessage implements round.Round.
+func (r *output) StoreMessage(msg round.Message) error {
+	r.SigmaReceived[msg.From] = msg.Content.(*message4).Sigma
+	return nil
+}
+
+// Finalize implements round.Round.
+//
+//   - reconstruct σ = γ·(m + r·x) by Lagrange interpolation of every signer's revealed σⱼ.
+//   - recover s = δ⁻¹·σ = k⁻¹·(m + r·x), the ECDSA signature's s component, and output (r, s).
+func (r *output) Finalize(chan<- *round.Message) (round.Round, error) {
+	lagrange := polynomial.Lagrange(r.Signers)
+	sigma := curve.NewScalar()
+	for _, j := range r.Signers {
+		term := curve.NewScalar().Multiply(lagrange[j], r.SigmaReceived[j])
+		sigma.Add(sigma, term)
+	}
+
+	deltaInv := curve.NewScalar().Invert(r.Delta)
+	s := curve.NewScalar().Multiply(deltaInv, sigma)
+
+	sig := &Signature{R: r.Rx, S: s}
+	if !sig.Verify(r.PublicKey, r.Message) {
+		return nil, ErrAborted
+	}
+
+	return &round.Output{Result: sig}, nil
+}
+
+// MessageContent implements round.Round.
+func (output) MessageContent() round.Content { return &message4{} }
+
+// Number implements round.Round.
+func (output) Number() round.Number { return 5 }
+
+// PreviousRound implements round.Round.
+func (r *output) PreviousRound() round.Round { return r.round4 }
@@ -0,0 +1,198 @@
+package sign_hm
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"github.com/taurusgroup/multi-party-sig/internal/hash"
+	"github.com/taurusgroup/multi-party-sig/internal/round"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/polynomial"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/sample"
+	"github.com/taurusgroup/multi-party-sig/pkg/party"
+	"github.com/taurusgroup/multi-party-sig/pkg/protocol"
+	"github.com/taurusgroup/multi-party-sig/protocols/cmp/keygen"
+)
+
+var (
+	_ round.Round = (*round1)(nil)
+	_ round.Round = (*round2)(nil)
+	_ round.Round = (*round3)(nil)
+	_ round.Round = (*round4)(nil)
+	_ round.Round = (*output)(nil)
+)
+
+var (
+	ErrInvalidFeldman = errors.New("sign_hm: Feldman commitment check failed")
+	ErrAborted        = errors.New("sign_hm: signer sent an inconsistent reshare")
+)
+
+type round1 struct {
+	*round.Helper
+
+	Config  *keygen.Config
+	Signers party.IDSlice
+	Message []byte
+
+	// PublicKey = Y, the aggregated group public key, used to verify the assembled signature
+	// before it is output.
+	PublicKey *curve.Point
+
+	IdentitySecret *curve.Scalar
+	IdentityPublic map[party.ID]*curve.Point
+
+	// kPoly = Kᵢ(X), our own degree-t contribution to the joint nonce polynomial.
+	kPoly *polynomial.Polynomial
+	KSelf *polynomial.Exponent
+	// gammaPoly = Γᵢ(X), our own degree-t contribution to the joint blinding polynomial.
+	gammaPoly *polynomial.Polynomial
+	GammaSelf *polynomial.Exponent
+	// xPoly = Xᵢ(X), a fresh degree-t re-sharing of our own Lagrange-scaled key share λᵢ·xᵢ, i.e.
+	// Xᵢ(0) = λᵢ·xᵢ. Re-randomizing the key share this way lets round2 multiply it against Γ the
+	// same way it multiplies K against Γ, since both operands are then evaluations of independent
+	// degree-t polynomials over the same signer set.
+	xPoly *polynomial.Polynomial
+	XSelf *polynomial.Exponent
+
+	// KContributions[i] = Kᵢ(X), as published by signer i. Seeded with our own.
+	KContributions map[party.ID]*polynomial.Exponent
+	// GammaContributions[i] = Γᵢ(X), as published by signer i. Seeded with our own.
+	GammaContributions map[party.ID]*polynomial.Exponent
+	// XContributions[i] = Xᵢ(X), as published by signer i. Seeded with our own.
+	XContributions map[party.ID]*polynomial.Exponent
+
+	// KShareReceived[i] = Kᵢ(j), our share of signer i's nonce contribution. Seeded with our own.
+	KShareReceived map[party.ID]*curve.Scalar
+	// GammaShareReceived[i] = Γᵢ(j), our share of signer i's blinding contribution.
+	GammaShareReceived map[party.ID]*curve.Scalar
+	// XShareReceived[i] = Xᵢ(j), our share of signer i's re-shared key contribution.
+	XShareReceived map[party.ID]*curve.Scalar
+}
+
+type message1 struct {
+	// KContribution = Kᵢ(X), the same for every recipient.
+	KContribution *polynomial.Exponent
+	// GammaContribution = Γᵢ(X), the same for every recipient.
+	GammaContribution *polynomial.Exponent
+	// XContribution = Xᵢ(X), the same for every recipient.
+	XContribution *polynomial.Exponent
+	// Ciphertext = Enc(Kᵢ(j), Γᵢ(j), Xᵢ(j)), addressed to this message's specific recipient.
+	Ciphertext []byte
+}
+
+// StartSignHM runs honest-majority threshold ECDSA signing on message, among exactly 2t+1 signers
+// where t = config.Threshold. identityPublic must contain an entry for every party in signers,
+// including config.ID, used to derive the per-pair encryption keys protecting the nonce shares
+// exchanged below.
+func StartSignHM(config *keygen.Config, signers []party.ID, message []byte, identitySecret *curve.Scalar, identityPublic map[party.ID]*curve.Point) protocol.StartFunc {
+	return func() (round.Round, protocol.Info, error) {
+		if len(message) == 0 {
+			return nil, nil, errors.New("sign_hm.StartSignHM: message is nil")
+		}
+
+		signerIDs := party.NewIDSlice(signers)
+		if !config.CanSign(signerIDs) {
+			return nil, nil, errors.New("sign_hm.StartSignHM: signers is not a valid signing subset")
+		}
+		threshold := int(config.Threshold)
+		if len(signerIDs) != 2*threshold+1 {
+			return nil, nil, fmt.Errorf("sign_hm.StartSignHM: honest-majority signing requires exactly 2t+1 = %d signers, got %d", 2*threshold+1, len(signerIDs))
+		}
+		for _, j := range signerIDs {
+			if _, ok := identityPublic[j]; !ok {
+				return nil, nil, fmt.Errorf("sign_hm.StartSignHM: missing identity key for %v", j)
+			}
+		}
+
+		selfID := config.ID
+
+		helper, err := round.NewHelper(
+			protocolID,
+			protocolRounds,
+			selfID,
+			signerIDs,
+			config,
+			signerIDs,
+			hash.BytesWithDomain{TheDomain: "Signature Message", Bytes: message},
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("sign_hm.StartSignHM: %w", err)
+		}
+
+		keyShare, err := config.KeyShare(signers)
+		if err != nil {
+			return nil, nil, fmt.Errorf("sign_hm.StartSignHM: %w", err)
+		}
+
+		kPoly := polynomial.NewPolynomial(threshold, sample.Scalar(rand.Reader))
+		KSelf := polynomial.NewPolynomialExponent(kPoly)
+		gammaPoly := polynomial.NewPolynomial(threshold, sample.Scalar(rand.Reader))
+		GammaSelf := polynomial.NewPolynomialExponent(gammaPoly)
+		xPoly := polynomial.NewPolynomial(threshold, keyShare.ECDSA)
+		XSelf := polynomial.NewPolynomialExponent(xPoly)
+
+		return &round1{
+			Helper:             helper,
+			Config:             config,
+			Signers:            signerIDs,
+			Message:            message,
+			PublicKey:          keyShare.PublicKey,
+			IdentitySecret:     identitySecret,
+			IdentityPublic:     identityPublic,
+			kPoly:              kPoly,
+			KSelf:              KSelf,
+			gammaPoly:          gammaPoly,
+			GammaSelf:          GammaSelf,
+			xPoly:              xPoly,
+			XSelf:              XSelf,
+			KContributions:     map[party.ID]*polynomial.Exponent{selfID: KSelf},
+			GammaContributions: map[party.ID]*polynomial.Exponent{selfID: GammaSelf},
+			XContributions:     map[party.ID]*polynomial.Exponent{selfID: XSelf},
+			KShareReceived:     map[party.ID]*curve.Scalar{selfID: kPoly.Evaluate(selfID.Scalar())},
+			GammaShareReceived: map[party.ID]*curve.Scalar{selfID: gammaPoly.Evaluate(selfID.Scalar())},
+			XShareReceived:     map[party.ID]*curve.Scalar{selfID: xPoly.Evaluate(selfID.Scalar())},
+		}, helper, nil
+	}
+}
+
+// VerifyMessage implements round.Round. Nothing precedes round1, so there is nothing to verify.
+func (round1) VerifyMessage(round.Message) error { return nil }
+
+// StoreMessage implements round.Round. Nothing precedes round1, so there is nothing to store.
+func (round1) StoreMessage(round.Message) error { return nil }
+
+// Finalize implements round.Round.
+//
+//   - encrypt and send our share of Kᵢ(X), Γᵢ(X) and Xᵢ(X), together with all three public
+//     commitments, to every other signer.
+func (r *round1) Finalize(out chan<- *round.Message) (round.Round, error) {
+	for _, j := range r.OtherPartyIDs() {
+		kShare := r.kPoly.Evaluate(j.Scalar())
+		gammaShare := r.gammaPoly.Evaluate(j.Scalar())
+		xShare := r.xPoly.Evaluate(j.Scalar())
+		ciphertext, err := encryptShares(r.IdentitySecret, r.IdentityPublic[j], transcriptAD(r.Hash()), kShare, gammaShare, xShare)
+		if err != nil {
+			return r, err
+		}
+		if err := r.SendMessage(out, &message1{
+			KContribution:     r.KSelf,
+			GammaContribution: r.GammaSelf,
+			XContribution:     r.XSelf,
+			Ciphertext:        ciphertext,
+		}, j); err != nil {
+			return r, err
+		}
+	}
+
+	return &round2{round1: r}, nil
+}
+
+// MessageContent implements round.Round.
+func (round1) MessageContent() round.Content { return &message1{} }
+
+// Number implements round.Round.
+func (round1) Number() round.Number { return 1 }
+
+// Init implements round.Content.
+func (m *message1) Init(curve.Curve) {}
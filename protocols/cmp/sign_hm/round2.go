@@ -0,0 +1,142 @@
+package sign_hm
+
+import (
+	"fmt"
+
+	"github.com/taurusgroup/multi-party-sig/internal/round"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/polynomial"
+	"github.com/taurusgroup/multi-party-sig/pkg/party"
+)
+
+type round2 struct {
+	*round1
+}
+
+// VerifyMessage implements round.Round.
+//
+//   - decrypt our share of signer i's Kᵢ(X), Γᵢ(X) and Xᵢ(X) contributions.
+//   - check each against its published Feldman commitment, evaluated at our own index.
+func (r *round2) VerifyMessage(msg round.Message) error {
+	from, body := msg.From, msg.Content.(*message1)
+	if body == nil || body.KContribution == nil || body.GammaContribution == nil || body.XContribution == nil {
+		return round.ErrInvalidContent
+	}
+
+	kShare, gammaShare, xShare, err := decryptShares(r.IdentitySecret, r.IdentityPublic[from], transcriptAD(r.HashForID(from)), body.Ciphertext)
+	if err != nil {
+		return err
+	}
+
+	self := r.SelfID().Scalar()
+	if !curve.NewIdentityPoint().ScalarBaseMult(kShare).Equal(body.KContribution.Evaluate(self)) {
+		return fmt.Errorf("%w: Kᵢ(X) from %v", ErrInvalidFeldman, from)
+	}
+	if !curve.NewIdentityPoint().ScalarBaseMult(gammaShare).Equal(body.GammaContribution.Evaluate(self)) {
+		return fmt.Errorf("%w: Γᵢ(X) from %v", ErrInvalidFeldman, from)
+	}
+	if !curve.NewIdentityPoint().ScalarBaseMult(xShare).Equal(body.XContribution.Evaluate(self)) {
+		return fmt.Errorf("%w: Xᵢ(X) from %v", ErrInvalidFeldman, from)
+	}
+	return nil
+}
+
+// StoreMessage implements round.Round.
+func (r *round2) StoreMessage(msg round.Message) error {
+	from, body := msg.From, msg.Content.(*message1)
+
+	kShare, gammaShare, xShare, err := decryptShares(r.IdentitySecret, r.IdentityPublic[from], transcriptAD(r.HashForID(from)), body.Ciphertext)
+	if err != nil {
+		return err
+	}
+
+	r.KContributions[from] = body.KContribution
+	r.GammaContributions[from] = body.GammaContribution
+	r.XContributions[from] = body.XContribution
+	r.KShareReceived[from] = kShare
+	r.GammaShareReceived[from] = gammaShare
+	r.XShareReceived[from] = xShare
+	return nil
+}
+
+// Finalize implements round.Round.
+//
+//   - sum every signer's contribution into our own share of the joint nonce k, blinding γ, and
+//     re-randomized key share x: KShare = Σᵢ Kᵢ(j), GammaShare = Σᵢ Γᵢ(j), XShare = Σᵢ Xᵢ(j).
+//   - locally compute this signer's evaluation of the two product polynomials needed to recover
+//     the signature without ever reconstructing k⁻¹ in the clear: δ = k·γ and σ' = γ·x, the
+//     pointwise products of two degree-t shares, hence evaluations of a degree-2t polynomial.
+//   - re-share each product at a fresh degree-t polynomial, and send the evaluations to every
+//     other signer, so that summing every signer's re-share in round3 turns these back into
+//     ordinary degree-t shares of δ and σ' (the classical BGW multiplication technique).
+func (r *round2) Finalize(out chan<- *round.Message) (round.Round, error) {
+	kShare := curve.NewScalar()
+	gammaShare := curve.NewScalar()
+	xShare := curve.NewScalar()
+	for _, i := range r.Signers {
+		kShare.Add(kShare, r.KShareReceived[i])
+		gammaShare.Add(gammaShare, r.GammaShareReceived[i])
+		xShare.Add(xShare, r.XShareReceived[i])
+	}
+
+	// Each dealer reshares its own point on the degree-2t product polynomial D(X) = K(X)·Γ(X),
+	// scaled by its own Lagrange coefficient for the signer set, so that summing every dealer's
+	// reshare and interpolating at 0 reconstructs D(0) = k·γ rather than Σᵢ Dᵢ(i). This mirrors
+	// xPoly in round1.go, which reshares the already Lagrange-scaled key share λᵢ·xᵢ.
+	lagrange := polynomial.Lagrange(r.Signers)
+	self := r.SelfID()
+	delta := curve.NewScalar().Multiply(lagrange[self], curve.NewScalar().Multiply(kShare, gammaShare))
+	sigmaPrime := curve.NewScalar().Multiply(lagrange[self], curve.NewScalar().Multiply(gammaShare, xShare))
+
+	threshold := r.Threshold()
+	deltaPoly := polynomial.NewPolynomial(threshold, delta)
+	DeltaSelf := polynomial.NewPolynomialExponent(deltaPoly)
+	sigmaPrimePoly := polynomial.NewPolynomial(threshold, sigmaPrime)
+	SigmaPrimeSelf := polynomial.NewPolynomialExponent(sigmaPrimePoly)
+
+	next := &round3{
+		round2:                  r,
+		KShare:                  kShare,
+		GammaShare:              gammaShare,
+		XShare:                  xShare,
+		deltaPoly:               deltaPoly,
+		DeltaSelf:               DeltaSelf,
+		sigmaPrimePoly:          sigmaPrimePoly,
+		SigmaPrimeSelf:          SigmaPrimeSelf,
+		DeltaContributions:      map[party.ID]*polynomial.Exponent{self: DeltaSelf},
+		SigmaPrimeContributions: map[party.ID]*polynomial.Exponent{self: SigmaPrimeSelf},
+		DeltaShareReceived:      map[party.ID]*curve.Scalar{self: deltaPoly.Evaluate(self.Scalar())},
+		SigmaPrimeShareReceived: map[party.ID]*curve.Scalar{self: sigmaPrimePoly.Evaluate(self.Scalar())},
+	}
+
+	for _, j := range r.OtherPartyIDs() {
+		dShare := deltaPoly.Evaluate(j.Scalar())
+		sShare := sigmaPrimePoly.Evaluate(j.Scalar())
+		ciphertext, err := encryptProductShares(r.IdentitySecret, r.IdentityPublic[j], transcriptAD(r.Hash()), dShare, sShare)
+		if err != nil {
+			return r, err
+		}
+		if err := r.SendMessage(out, &message2{
+			DeltaContribution:      DeltaSelf,
+			SigmaPrimeContribution: SigmaPrimeSelf,
+			Ciphertext:             ciphertext,
+		}, j); err != nil {
+			return r, err
+		}
+	}
+
+	return next, nil
+}
+
+// MessageContent implements round.Round.
+func (round2) MessageContent() round.Content { return &message1{} }
+
+// Number implements round.Round.
+func (round2) Number() round.Number { return 2 }
+
+// PreviousRound implements round.Round.
+func (r *round2) PreviousRound() round.Round { return r.round1 }
+
+// Threshold returns t, the honest-majority configured threshold this signing session was started
+// with. Exposed as a method since only round1 carries the original Config.
+func (r *round1) Threshold() int { return int(r.Config.Threshold) }
@@ -0,0 +1,132 @@
+package sign_hm
+
+import (
+	"fmt"
+
+	"github.com/taurusgroup/multi-party-sig/internal/round"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/polynomial"
+	"github.com/taurusgroup/multi-party-sig/pkg/party"
+)
+
+type round3 struct {
+	*round2
+
+	// KShare, GammaShare, XShare are our final degree-t shares of the joint nonce k, blinding γ,
+	// and re-randomized key share x, summed in round2.Finalize.
+	KShare, GammaShare, XShare *curve.Scalar
+
+	// deltaPoly = Dᵢ(X), our own fresh degree-t re-sharing of λᵢ·Kᵢ·Γᵢ := λᵢ·KShare·GammaShare,
+	// the local evaluation of the product polynomial K(X)·Γ(X) at our own index, weighted by our
+	// Lagrange coefficient for the signer set.
+	deltaPoly *polynomial.Polynomial
+	DeltaSelf *polynomial.Exponent
+	// sigmaPrimePoly = Sᵢ(X), our own fresh degree-t re-sharing of λᵢ·Γᵢ·Xᵢ :=
+	// λᵢ·GammaShare·XShare.
+	sigmaPrimePoly *polynomial.Polynomial
+	SigmaPrimeSelf *polynomial.Exponent
+
+	// DeltaContributions[i] = Dᵢ(X), as published by signer i. Seeded with our own.
+	DeltaContributions map[party.ID]*polynomial.Exponent
+	// SigmaPrimeContributions[i] = Sᵢ(X), as published by signer i. Seeded with our own.
+	SigmaPrimeContributions map[party.ID]*polynomial.Exponent
+
+	// DeltaShareReceived[i] = Dᵢ(j), our share of signer i's re-shared Kᵢ·Γᵢ product. Seeded
+	// with our own.
+	DeltaShareReceived map[party.ID]*curve.Scalar
+	// SigmaPrimeShareReceived[i] = Sᵢ(j), our share of signer i's re-shared Γᵢ·Xᵢ product. Seeded
+	// with our own.
+	SigmaPrimeShareReceived map[party.ID]*curve.Scalar
+}
+
+type message2 struct {
+	// DeltaContribution = Dᵢ(X), the same for every recipient.
+	DeltaContribution *polynomial.Exponent
+	// SigmaPrimeContribution = Sᵢ(X), the same for every recipient.
+	SigmaPrimeContribution *polynomial.Exponent
+	// Ciphertext = Enc(Dᵢ(j), Sᵢ(j)), addressed to this message's specific recipient.
+	Ciphertext []byte
+}
+
+// VerifyMessage implements round.Round.
+//
+//   - decrypt our share of signer i's re-shared Kᵢ·Γᵢ and Γᵢ·Xᵢ products.
+//   - check each against its published Feldman commitment, evaluated at our own index.
+func (r *round3) VerifyMessage(msg round.Message) error {
+	from, body := msg.From, msg.Content.(*message2)
+	if body == nil || body.DeltaContribution == nil || body.SigmaPrimeContribution == nil {
+		return round.ErrInvalidContent
+	}
+
+	deltaShare, sigmaShare, err := decryptProductShares(r.IdentitySecret, r.IdentityPublic[from], transcriptAD(r.HashForID(from)), body.Ciphertext)
+	if err != nil {
+		return err
+	}
+
+	self := r.SelfID().Scalar()
+	if !curve.NewIdentityPoint().ScalarBaseMult(deltaShare).Equal(body.DeltaContribution.Evaluate(self)) {
+		return fmt.Errorf("%w: Dᵢ(X) from %v", ErrInvalidFeldman, from)
+	}
+	if !curve.NewIdentityPoint().ScalarBaseMult(sigmaShare).Equal(body.SigmaPrimeContribution.Evaluate(self)) {
+		return fmt.Errorf("%w: Sᵢ(X) from %v", ErrInvalidFeldman, from)
+	}
+	return nil
+}
+
+// StoreMessage implements round.Round.
+func (r *round3) StoreMessage(msg round.Message) error {
+	from, body := msg.From, msg.Content.(*message2)
+
+	deltaShare, sigmaShare, err := decryptProductShares(r.IdentitySecret, r.IdentityPublic[from], transcriptAD(r.HashForID(from)), body.Ciphertext)
+	if err != nil {
+		return err
+	}
+
+	r.DeltaContributions[from] = body.DeltaContribution
+	r.SigmaPrimeContributions[from] = body.SigmaPrimeContribution
+	r.DeltaShareReceived[from] = deltaShare
+	r.SigmaPrimeShareReceived[from] = sigmaShare
+	return nil
+}
+
+// Finalize implements round.Round.
+//
+//   - sum every signer's re-share into our own final share of δ = k·γ and σ' = γ·x, now reduced
+//     back down to ordinary degree-t shares.
+//   - broadcast our share of δ in the clear: since δ = k·γ reveals nothing about k on its own (γ
+//     is uniform and independent of k), this is safe to reconstruct in full, and doing so is what
+//     lets every signer divide σ = γ·(m + r·x) by it in output.Finalize without ever touching k⁻¹
+//     directly.
+func (r *round3) Finalize(out chan<- *round.Message) (round.Round, error) {
+	deltaFinal := curve.NewScalar()
+	sigmaPrimeFinal := curve.NewScalar()
+	for _, i := range r.Signers {
+		deltaFinal.Add(deltaFinal, r.DeltaShareReceived[i])
+		sigmaPrimeFinal.Add(sigmaPrimeFinal, r.SigmaPrimeShareReceived[i])
+	}
+
+	next := &round4{
+		round3:          r,
+		DeltaShare:      deltaFinal,
+		SigmaPrimeShare: sigmaPrimeFinal,
+		DeltaReceived:   map[party.ID]*curve.Scalar{r.SelfID(): deltaFinal},
+	}
+
+	if err := r.SendMessage(out, &message3{Delta: deltaFinal}, ""); err != nil {
+		return r, err
+	}
+
+	return next, nil
+}
+
+// MessageContent implements round.Round.
+func (round3) MessageContent() round.Content { return &message2{} }
+
+// Number implements round.Round.
+func (round3) Number() round.Number { return 3 }
+
+// PreviousRound implements round.Round.
+func (r *round3) PreviousRound() round.Round { return r.round2 }
+
+// Init implements round.Content.
+func (m *message2) Init(curve.Curve) {}
@@ -0,0 +1,104 @@
+package sign_hm
+
+import (
+	"github.com/taurusgroup/multi-party-sig/internal/round"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/polynomial"
+	"github.com/taurusgroup/multi-party-sig/pkg/party"
+)
+
+type round4 struct {
+	*round3
+
+	// DeltaShare, SigmaPrimeShare are our final degree-t shares of δ = k·γ and σ' = γ·x, summed
+	// in round3.Finalize.
+	DeltaShare, SigmaPrimeShare *curve.Scalar
+
+	// DeltaReceived[j] = δⱼ, as broadcast in the clear by signer j. Seeded with our own.
+	DeltaReceived map[party.ID]*curve.Scalar
+
+	// R, Rx are set in Finalize: R = k·G = Σᵢ Kᵢ(0), and Rx = Rₓ mod q, the ECDSA signature's r
+	// component.
+	R  *curve.Point
+	Rx *curve.Scalar
+}
+
+type message3 struct {
+	// Delta = δⱼ, this signer's share of δ = k·γ, revealed in the clear.
+	Delta *curve.Scalar
+}
+
+// VerifyMessage implements round.Round.
+func (round4) VerifyMessage(msg round.Message) error {
+	body, ok := msg.Content.(*message3)
+	if !ok || body == nil || body.Delta == nil {
+		return round.ErrInvalidContent
+	}
+	return nil
+}
+
+// StoreMessage implements round.Round.
+func (r *round4) StoreMessage(msg round.Message) error {
+	r.DeltaReceived[msg.From] = msg.Content.(*message3).Delta
+	return nil
+}
+
+// Finalize implements round.Round.
+//
+//   - reconstruct δ = k·γ by Lagrange interpolation of every signer's revealed δⱼ.
+//   - recover R = k·G = Σᵢ Kᵢ(0), the sum of the constant terms of every signer's public
+//     commitment to its nonce contribution published in round1, and take r = Rₓ.
+//   - fold our final share of γ and γ·x into a share of σ = γ·(m + r·x), still at degree t, and
+//     broadcast it in the clear: unlike δ, σ alone would leak x together with γ, but dividing the
+//     reconstructed σ by δ below recovers only s = k⁻¹·(m + r·x), never γ or x individually.
+func (r *round4) Finalize(out chan<- *round.Message) (round.Round, error) {
+	lagrange := polynomial.Lagrange(r.Signers)
+	delta := curve.NewScalar()
+	for _, j := range r.Signers {
+		term := curve.NewScalar().Multiply(lagrange[j], r.DeltaReceived[j])
+		delta.Add(delta, term)
+	}
+
+	zero := curve.NewScalar()
+	R := curve.NewIdentityPoint()
+	for _, i := range r.Signers {
+		R.Add(R, r.KContributions[i].Evaluate(zero))
+	}
+	r.R = R
+
+	rx, err := xCoordScalar(R)
+	if err != nil {
+		return r, err
+	}
+	r.Rx = rx
+
+	m := messageHash(r.Message)
+	mGamma := curve.NewScalar().Multiply(m, r.GammaShare)
+	rSigmaPrime := curve.NewScalar().Multiply(rx, r.SigmaPrimeShare)
+	sigmaShare := curve.NewScalar().Add(mGamma, rSigmaPrime)
+
+	next := &output{
+		round4:        r,
+		Delta:         delta,
+		SigmaShare:    sigmaShare,
+		SigmaReceived: map[party.ID]*curve.Scalar{r.SelfID(): sigmaShare},
+	}
+
+	if err := r.SendMessage(out, &message4{Sigma: sigmaShare}, ""); err != nil {
+		return r, err
+	}
+
+	return next, nil
+}
+
+// MessageContent implements round.Round.
+func (round4) MessageContent() round.Content { return &message3{} }
+
+// Number implements round.Round.
+func (round4) Number() round.Number { return 4 }
+
+// PreviousRound implements round.Round.
+func (r *round4) PreviousRound() round.Round { return r.round3 }
+
+// Init implements round.Content.
+func (m *message3) Init(curve.Curve) {}
@@ -0,0 +1,29 @@
+// Package sign_hm implements an honest-majority (t < n/2) threshold ECDSA signer, as a lighter
+// alternative to protocols/cmp/sign for deployments that can assume fewer than half the signers
+// are corrupt. Keys come from the same Feldman VSS used by protocols/cmp/keygen (config.ECDSA and
+// config.Public[j].ECDSA, the raw, unscaled Shamir shares and their commitments); this package
+// never touches a Config's Paillier or Pedersen material, since it needs neither.
+//
+// Because the honest-majority assumption lets us dispense with Paillier-based multiplication
+// (MtA), every multiplication of two secret-shared values here instead uses the classical
+// Shamir/BGW technique: the pointwise product of two degree-t shares lies on a degree-2t
+// polynomial, which a quorum of 2t+1 signers can jointly re-share at degree t (with Feldman
+// commitments proving every reshare is consistent) and then reconstruct by Lagrange
+// interpolation. StartSignHM therefore requires exactly 2t+1 signers, not just t+1.
+//
+// Signing needs two such products. First, k·γ for a nonce k and an independent random blinding γ,
+// both freshly generated per signature: revealing k·γ leaks nothing about k (γ is uniform and
+// independent). The nonce commitment R = k·G needs no multiplication: it is the sum of the
+// constant terms of every signer's public commitment to its nonce contribution. Second, γ·x, the
+// blinded product of γ with our own key
+// share x: every signer locally folds this into a share of γ·(m + r·x), which the group sums and
+// divides by k·γ to recover s = k⁻¹·(m + r·x) — again never reconstructing k⁻¹ in the clear, since
+// doing so would immediately leak x from the finished signature.
+package sign_hm
+
+import "github.com/taurusgroup/multi-party-sig/pkg/protocol/types"
+
+const (
+	protocolID     types.ProtocolID  = "cmp/sign-hm"
+	protocolRounds types.RoundNumber = 5
+)
@@ -0,0 +1,140 @@
+package sign_hm
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/taurusgroup/multi-party-sig/internal/round"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/polynomial"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/sample"
+	"github.com/taurusgroup/multi-party-sig/pkg/party"
+	"github.com/taurusgroup/multi-party-sig/protocols/cmp/keygen"
+)
+
+// shamirHMConfigs builds threshold-of-len(ids) keygen.Configs for ids, sharing a single fresh
+// ECDSA secret key via a degree-threshold Shamir polynomial, the same shape protocols/cmp/keygen
+// would hand back. len(ids) must equal 2*threshold+1, as required by StartSignHM.
+func shamirHMConfigs(ids []party.ID, threshold int) (map[party.ID]*keygen.Config, *curve.Point) {
+	poly := polynomial.NewPolynomial(threshold, sample.Scalar(rand.Reader))
+	publicKey := curve.NewIdentityPoint().ScalarBaseMult(poly.Evaluate(curve.NewScalar()))
+
+	shares := make(map[party.ID]*curve.Scalar, len(ids))
+	public := make(map[party.ID]*keygen.Public, len(ids))
+	for _, id := range ids {
+		share := poly.Evaluate(id.Scalar())
+		shares[id] = share
+		public[id] = &keygen.Public{ECDSA: curve.NewIdentityPoint().ScalarBaseMult(share)}
+	}
+
+	configs := make(map[party.ID]*keygen.Config, len(ids))
+	for _, id := range ids {
+		configs[id] = &keygen.Config{
+			Threshold: uint32(threshold),
+			Public:    public,
+			Secret:    &keygen.Secret{ID: id, ECDSA: shares[id]},
+		}
+	}
+	return configs, publicKey
+}
+
+// runHMRound finalizes every signer's current round, then delivers every resulting message to
+// every other signer's VerifyMessage and StoreMessage, returning the next round for each signer.
+func runHMRound(t *testing.T, rounds map[party.ID]round.Round) map[party.ID]round.Round {
+	t.Helper()
+
+	next := make(map[party.ID]round.Round, len(rounds))
+	var outgoing []round.Message
+	for id, r := range rounds {
+		out := make(chan *round.Message, len(rounds))
+		nr, err := r.Finalize(out)
+		if err != nil {
+			t.Fatalf("Finalize for %v: %v", id, err)
+		}
+		close(out)
+		next[id] = nr
+		for m := range out {
+			outgoing = append(outgoing, *m)
+		}
+	}
+
+	for _, m := range outgoing {
+		for id, r := range next {
+			if m.From == id || (m.To != "" && m.To != id) {
+				continue
+			}
+			if err := r.VerifyMessage(m); err != nil {
+				t.Fatalf("VerifyMessage %v -> %v: %v", m.From, id, err)
+			}
+			if err := r.StoreMessage(m); err != nil {
+				t.Fatalf("StoreMessage %v -> %v: %v", m.From, id, err)
+			}
+		}
+	}
+
+	return next
+}
+
+// TestSignHMRoundTrip runs a full honest-majority signing session for a (3-of-5) Shamir-shared
+// key and checks that the resulting ECDSA signature verifies against the real aggregated group
+// key. This would have caught round2.Finalize reconstructing δ and σ' from unweighted dealer
+// shares instead of Σᵢ λᵢ·(Kᵢ·Γᵢ) and Σᵢ λᵢ·(Γᵢ·Xᵢ), which made output.Finalize's sig.Verify
+// check fail and every signing attempt return ErrAborted.
+func TestSignHMRoundTrip(t *testing.T) {
+	threshold := 2
+	signers := []party.ID{"1", "2", "3", "4", "5"} // 2t+1 = 5 signers, as StartSignHM requires.
+
+	configs, publicKey := shamirHMConfigs(signers, threshold)
+
+	identitySecret := make(map[party.ID]*curve.Scalar, len(signers))
+	identityPublic := make(map[party.ID]*curve.Point, len(signers))
+	for _, id := range signers {
+		s := sample.Scalar(rand.Reader)
+		identitySecret[id] = s
+		identityPublic[id] = curve.NewIdentityPoint().ScalarBaseMult(s)
+	}
+
+	message := []byte("sign_hm round-trip test message")
+
+	rounds := make(map[party.ID]round.Round, len(signers))
+	for _, id := range signers {
+		startedRound, _, err := StartSignHM(configs[id], signers, message, identitySecret[id], identityPublic)()
+		if err != nil {
+			t.Fatalf("StartSignHM(%v): %v", id, err)
+		}
+		rounds[id] = startedRound
+	}
+
+	// round1 -> round2 -> round3 -> round4 -> output.
+	for i := 0; i < 4; i++ {
+		rounds = runHMRound(t, rounds)
+	}
+
+	var sig *Signature
+	for id, r := range rounds {
+		out := make(chan *round.Message, 1)
+		result, err := r.Finalize(out)
+		if err != nil {
+			t.Fatalf("output.Finalize for %v: %v", id, err)
+		}
+		close(out)
+
+		res, ok := result.(*round.Output)
+		if !ok {
+			t.Fatalf("output.Finalize for %v did not return a round.Output", id)
+		}
+		partySig, ok := res.Result.(*Signature)
+		if !ok {
+			t.Fatalf("output.Finalize for %v did not return a *Signature", id)
+		}
+		if sig == nil {
+			sig = partySig
+		} else if !sig.R.Equal(partySig.R) || !sig.S.Equal(partySig.S) {
+			t.Fatalf("signers disagree on the assembled signature")
+		}
+	}
+
+	if !sig.Verify(publicKey, message) {
+		t.Fatal("aggregated signature failed to verify against the real group public key")
+	}
+}
@@ -0,0 +1,70 @@
+package sign_hm
+
+import (
+	"errors"
+
+	"github.com/cronokirby/safenum"
+	"github.com/taurusgroup/multi-party-sig/internal/hash"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/sample"
+)
+
+// Signature is an ECDSA signature (r, s).
+type Signature struct {
+	R *curve.Scalar
+	S *curve.Scalar
+}
+
+// messageHash reduces message to a scalar m = H(message) mod q, the usual ECDSA message digest.
+func messageHash(message []byte) *curve.Scalar {
+	h := hash.New()
+	_ = h.WriteAny(hash.BytesWithDomain{TheDomain: "ECDSA Message", Bytes: message})
+	return sample.Scalar(h)
+}
+
+// Verify reports whether sig is a valid ECDSA signature on message under public, i.e. whether,
+// for u1 = m·s⁻¹ and u2 = r·s⁻¹, the point R' = u1·G + u2·Y has x-coordinate r.
+func (sig Signature) Verify(public *curve.Point, message []byte) bool {
+	m := messageHash(message)
+
+	sInv := curve.NewScalar().Invert(sig.S)
+	u1 := curve.NewScalar().Multiply(m, sInv)
+	u2 := curve.NewScalar().Multiply(sig.R, sInv)
+
+	Rprime := curve.NewIdentityPoint().Add(
+		curve.NewIdentityPoint().ScalarBaseMult(u1),
+		curve.NewIdentityPoint().ScalarMult(u2, public),
+	)
+	if Rprime.IsIdentity() {
+		return false
+	}
+
+	r, err := xCoordScalar(Rprime)
+	if err != nil {
+		return false
+	}
+	return r.Equal(sig.R)
+}
+
+// xCoordScalar extracts R's affine x-coordinate and reduces it mod the curve's group order, as
+// required to turn a nonce commitment R into the ECDSA signature component r = Rₓ.
+func xCoordScalar(R *curve.Point) (*curve.Scalar, error) {
+	compressed, err := R.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if len(compressed) < 1 {
+		return nil, errors.New("sign_hm: invalid point encoding")
+	}
+	// compressed = 0x02/0x03 prefix ∥ 32-byte big-endian x-coordinate.
+	x := compressed[1:]
+
+	r := curve.NewScalar()
+	if err := r.UnmarshalBinary(x); err != nil {
+		// Fall back to an explicit modular reduction if the coordinate, taken as a raw scalar
+		// encoding, is out of range (this only happens with negligible probability).
+		n := new(safenum.Nat).SetBytes(x)
+		r = curve.NewScalarInt(new(safenum.Int).SetNat(n))
+	}
+	return r, nil
+}
@@ -0,0 +1,77 @@
+package dkg_rabin
+
+import (
+	"errors"
+
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/party"
+)
+
+// Public holds the public Schnorr share for a single party, as produced by this DKG.
+type Public struct {
+	// ECDSA = Xⱼ = F(j), this party's public key share.
+	ECDSA *curve.Point
+}
+
+// SchnorrConfig is the result of running this DKG. It has the same shape as
+// simplpedpop.SchnorrConfig, with one addition: Disqualified lists every dealer whose contribution
+// was excluded from the sum below because it failed (or lost) a complaint/justification exchange.
+// Dealers in Disqualified, including possibly our own ID, take no part in PublicKey or ECDSA; a
+// caller that finds its own ID there should treat the run as having failed for it specifically,
+// rather than trust the resulting share.
+type SchnorrConfig struct {
+	// ID is our own party ID.
+	ID party.ID
+	// Threshold is the minimum number of parties needed to reconstruct or use the secret.
+	Threshold int
+	// Public[j] is the public share of party j, for j ∈ QUAL.
+	Public map[party.ID]*Public
+	// PublicKey = Y, the aggregated group public key, summed over QUAL only.
+	PublicKey *curve.Point
+	// ECDSA = xᵢ, our own secret share, summed over QUAL only.
+	ECDSA *curve.Scalar
+	// Disqualified lists every party excluded from QUAL, either because their VSS share was
+	// successfully disputed, or because they raised a complaint that the accused dealer went on to
+	// justify.
+	Disqualified party.IDSlice
+}
+
+// PartyID implements frost.Config.
+func (c *SchnorrConfig) PartyID() party.ID { return c.ID }
+
+// SecretECDSA implements frost.Config.
+func (c *SchnorrConfig) SecretECDSA() *curve.Scalar { return c.ECDSA }
+
+// PublicECDSA implements frost.Config.
+func (c *SchnorrConfig) PublicECDSA(id party.ID) *curve.Point { return c.Public[id].ECDSA }
+
+// CanSign implements frost.Config.
+//
+// signers can reconstruct the secret key if and only if there are strictly more than Threshold of
+// them, and each of them is a qualified party (i.e. not in Disqualified) this Config knows a public
+// share for.
+func (c *SchnorrConfig) CanSign(signers party.IDSlice) bool {
+	if len(signers) <= c.Threshold {
+		return false
+	}
+	if !signers.Contains(c.ID) {
+		return false
+	}
+	for _, j := range signers {
+		if _, ok := c.Public[j]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate implements frost.Config.
+func (c *SchnorrConfig) Validate() error {
+	if c == nil || c.PublicKey == nil || c.ECDSA == nil {
+		return errors.New("dkg_rabin: config is invalid")
+	}
+	if _, ok := c.Public[c.ID]; !ok {
+		return errors.New("dkg_rabin: config does not contain our own public share")
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+// Package dkg_rabin implements a Feldman/Rabin-style VSS distributed key generation protocol with
+// explicit complaint and justification handling, turning the fail-stop behavior of
+// protocols/simplpedpop (and protocols/cmp/keygen's round4.StoreMessage, which simply aborts with
+// ErrRound4VSS on a bad share) into an identifiable-abort protocol: a misbehaving dealer gets
+// excluded rather than taking down the whole run.
+//
+// The happy path is identical to simplpedpop: every party deals a fresh degree-t polynomial,
+// broadcasting its Feldman commitment and handing every other party an encrypted evaluation of it.
+// What differs is what happens when a recipient's decrypted share fails the VSS check
+// share·G == Fⱼ(i): instead of returning an error, the recipient broadcasts a Complaint naming the
+// dealer j. The accused dealer then has one round to broadcast a Justification revealing fⱼ(i) in
+// the clear; every party can verify it against the already-published Fⱼ(X) without needing to see
+// the original ciphertext. If the justification checks out, the complainant is deemed at fault
+// (either a false accusation or a decryption bug on their end); if the dealer fails to justify, or
+// justifies with an inconsistent share, the dealer is excluded instead.
+//
+// The set of dealers whose contributions survive this process, QUAL, is what the final key is
+// assembled from; everyone who raised or lost a justified complaint is dropped from QUAL and
+// appears in the result's Disqualified list, so callers can react (e.g. re-run the DKG with a
+// smaller party set) instead of the whole session simply failing.
+package dkg_rabin
+
+import (
+	"github.com/taurusgroup/multi-party-sig/pkg/protocol/types"
+)
+
+const (
+	protocolID     types.ProtocolID  = "dkg_rabin/keygen"
+	protocolRounds types.RoundNumber = 4
+)
@@ -0,0 +1,97 @@
+package dkg_rabin
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"io"
+
+	"github.com/taurusgroup/multi-party-sig/internal/hash"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+)
+
+// sharedKey derives the symmetric AEAD key used to encrypt a share sent from us to peer, given our
+// long-term identity secret and peer's long-term identity public key. Both sides of the pair
+// arrive at the same key since ECDH is symmetric: ourSecret·peerPublic == peerSecret·ourPublic.
+func sharedKey(ourSecret *curve.Scalar, peerPublic *curve.Point) ([]byte, error) {
+	secretPoint := curve.NewIdentityPoint().ScalarMult(ourSecret, peerPublic)
+
+	h := hash.New()
+	if err := h.WriteAny(hash.BytesWithDomain{TheDomain: "Rabin VSS Share Encryption"}, secretPoint); err != nil {
+		return nil, err
+	}
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(h, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// encryptShare encrypts share under the AEAD key shared between us and recipient, binding the
+// ciphertext to associatedData (the session transcript hash) so that a ciphertext from one DKG
+// run cannot be replayed into another.
+func encryptShare(ourSecret *curve.Scalar, peerPublic *curve.Point, associatedData []byte, share *curve.Scalar) ([]byte, error) {
+	key, err := sharedKey(ourSecret, peerPublic)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := share.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, associatedData), nil
+}
+
+// decryptShare reverses encryptShare.
+func decryptShare(ourSecret *curve.Scalar, peerPublic *curve.Point, associatedData []byte, ciphertext []byte) (*curve.Scalar, error) {
+	key, err := sharedKey(ourSecret, peerPublic)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("dkg_rabin: ciphertext too short")
+	}
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+
+	plaintext, err := aead.Open(nil, nonce, sealed, associatedData)
+	if err != nil {
+		return nil, errors.New("dkg_rabin: failed to decrypt share")
+	}
+
+	share := curve.NewScalar()
+	if err := share.UnmarshalBinary(plaintext); err != nil {
+		return nil, err
+	}
+	return share, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// transcriptAD extracts a fixed-size digest out of h to use as AEAD associated data, binding an
+// encrypted share to everything already written to h (party identities, threshold, protocol ID).
+func transcriptAD(h *hash.Hash) []byte {
+	ad := make([]byte, 32)
+	_, _ = io.ReadFull(h, ad)
+	return ad
+}
@@ -0,0 +1,116 @@
+package dkg_rabin
+
+import (
+	"github.com/taurusgroup/multi-party-sig/internal/round"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/party"
+)
+
+type output struct {
+	*round3
+
+	// Justifications[j][i] = fⱼ(i), as revealed in the clear by dealer j in response to a
+	// complaint from i.
+	Justifications map[party.ID]map[party.ID]*curve.Scalar
+}
+
+// VerifyMessage implements round.Round.
+func (output) VerifyMessage(msg round.Message) error {
+	body, ok := msg.Content.(*message3)
+	if !ok || body == nil {
+		return round.ErrInvalidContent
+	}
+	return nil
+}
+
+// StoreMessage implements round.Round.
+func (r *output) StoreMessage(msg round.Message) error {
+	body := msg.Content.(*message3)
+	shares := make(map[party.ID]*curve.Scalar, len(body.Justifications))
+	for _, j := range body.Justifications {
+		shares[j.For] = j.Share
+	}
+	r.Justifications[msg.From] = shares
+	return nil
+}
+
+// Finalize implements round.Round.
+//
+//   - resolve every complaint raised in round2: if the accused dealer justified it with a share
+//     matching their published commitment, the complainant is at fault and is excluded; otherwise
+//     the dealer is excluded, whether for failing to justify at all or for justifying with an
+//     inconsistent share.
+//   - QUAL is every dealer not excluded this way; if too many dealers were disqualified to leave a
+//     reconstructable threshold, abort instead of emitting a Config. Otherwise sum every dealer in
+//     QUAL into our own secret share, the group public key, and every party's public share, exactly
+//     as in protocols/simplpedpop, but restricted to QUAL.
+func (r *output) Finalize(chan<- *round.Message) (round.Round, error) {
+	disqualified := map[party.ID]bool{}
+	for complainant, against := range r.ComplaintsReceived {
+		for _, dealer := range against {
+			expected := r.VSSPolynomials[dealer].Evaluate(complainant.Scalar())
+			share, ok := r.Justifications[dealer][complainant]
+			if ok && curve.NewIdentityPoint().ScalarBaseMult(share).Equal(expected) {
+				disqualified[complainant] = true
+			} else {
+				disqualified[dealer] = true
+			}
+		}
+	}
+
+	var qualIDs, dqIDs []party.ID
+	for _, j := range r.PartyIDs() {
+		if disqualified[j] {
+			dqIDs = append(dqIDs, j)
+		} else {
+			qualIDs = append(qualIDs, j)
+		}
+	}
+	qual := party.NewIDSlice(qualIDs)
+	dq := party.NewIDSlice(dqIDs)
+	if len(qual) <= r.Threshold {
+		return nil, ErrQUALTooSmall
+	}
+
+	x := curve.NewScalar()
+	Y := curve.NewIdentityPoint()
+	for _, j := range qual {
+		share, ok := r.ShareReceived[j]
+		if !ok {
+			// We complained about j and lost the dispute: j's justification gives us the share we
+			// failed to decrypt ourselves.
+			share = r.Justifications[j][r.SelfID()]
+		}
+		x.Add(x, share)
+		Y.Add(Y, r.VSSPolynomials[j].Evaluate(curve.NewScalar()))
+	}
+
+	Public := make(map[party.ID]*Public, len(qual))
+	for _, k := range qual {
+		Xk := curve.NewIdentityPoint()
+		for _, j := range qual {
+			Xk.Add(Xk, r.VSSPolynomials[j].Evaluate(k.Scalar()))
+		}
+		Public[k] = &Public{ECDSA: Xk}
+	}
+
+	config := &SchnorrConfig{
+		ID:           r.SelfID(),
+		Threshold:    r.Threshold,
+		Public:       Public,
+		PublicKey:    Y,
+		ECDSA:        x,
+		Disqualified: dq,
+	}
+
+	return &round.Output{Result: config}, nil
+}
+
+// MessageContent implements round.Round.
+func (output) MessageContent() round.Content { return &message3{} }
+
+// Number implements round.Round.
+func (output) Number() round.Number { return 4 }
+
+// PreviousRound implements round.Round.
+func (r *output) PreviousRound() round.Round { return r.round3 }
@@ -0,0 +1,152 @@
+package dkg_rabin
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"github.com/taurusgroup/multi-party-sig/internal/hash"
+	"github.com/taurusgroup/multi-party-sig/internal/round"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/polynomial"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/sample"
+	"github.com/taurusgroup/multi-party-sig/pkg/party"
+	"github.com/taurusgroup/multi-party-sig/pkg/protocol"
+	zksch "github.com/taurusgroup/multi-party-sig/pkg/zk/sch"
+)
+
+var (
+	_ round.Round = (*round1)(nil)
+	_ round.Round = (*round2)(nil)
+	_ round.Round = (*round3)(nil)
+	_ round.Round = (*output)(nil)
+)
+
+var ErrInvalidProof = errors.New("dkg_rabin: Schnorr proof of knowledge is invalid")
+
+// ErrQUALTooSmall is returned by output.Finalize when disqualifying every dealer implicated in a
+// complaint leaves QUAL with Threshold or fewer members, too few to reconstruct the secret.
+var ErrQUALTooSmall = errors.New("dkg_rabin: QUAL does not clear the threshold")
+
+type round1 struct {
+	*round.Helper
+
+	Threshold int
+
+	// IdentitySecret is our long-term ECDH secret, used to derive the per-pair AEAD key that
+	// protects the share we send to every other party.
+	IdentitySecret *curve.Scalar
+	// IdentityPublic[j] is party j's long-term ECDH public key.
+	IdentityPublic map[party.ID]*curve.Point
+
+	// fPoly = fᵢ(X), our own degree-Threshold polynomial.
+	fPoly *polynomial.Polynomial
+	// FSelf = Fᵢ(X) = fᵢ(X)·G, the public commitment to fPoly.
+	FSelf *polynomial.Exponent
+
+	// VSSPolynomials[j] = Fⱼ(X), as published by party j. Seeded with our own.
+	VSSPolynomials map[party.ID]*polynomial.Exponent
+	// ShareReceived[j] = fⱼ(i), our share of party j's polynomial, taken from the ciphertext j
+	// addressed to us. Seeded with our own. If we complained about j, there is no entry for j here;
+	// output.Finalize takes the share j revealed in the clear in round3 instead.
+	ShareReceived map[party.ID]*curve.Scalar
+
+	// Complaints[i] lists every dealer that party i has complained against.
+	Complaints map[party.ID][]party.ID
+}
+
+type message1 struct {
+	// VSSPolynomial = Fⱼ(X), the same for every recipient.
+	VSSPolynomial *polynomial.Exponent
+	// Proof is a Schnorr proof of knowledge of fⱼ(0), the same for every recipient.
+	Proof *zksch.Proof
+	// Ciphertext = Enc(fⱼ(i)), addressed to this message's specific recipient.
+	Ciphertext []byte
+}
+
+// Start runs this DKG among partyIDs, with the given threshold and long-term identity keys used to
+// derive the per-pair encryption keys that protect each party's share. identityPublic must contain
+// an entry for every party in partyIDs, including selfID. Unlike protocols/simplpedpop, a bad share
+// does not abort the run: the recipient raises a complaint in round2, the accused dealer gets a
+// chance to justify in round3, and whichever of the two turns out to be at fault is excluded from
+// the final QUAL set instead.
+func Start(selfID party.ID, partyIDs []party.ID, threshold int, identitySecret *curve.Scalar, identityPublic map[party.ID]*curve.Point) protocol.StartFunc {
+	return func() (round.Round, protocol.Info, error) {
+		ids := party.NewIDSlice(partyIDs)
+		if threshold < 1 || threshold >= len(ids) {
+			return nil, nil, fmt.Errorf("dkg_rabin.Start: invalid threshold %d for %d parties", threshold, len(ids))
+		}
+		for _, j := range ids {
+			if _, ok := identityPublic[j]; !ok {
+				return nil, nil, fmt.Errorf("dkg_rabin.Start: missing identity key for %v", j)
+			}
+		}
+
+		helper, err := round.NewHelper(
+			protocolID,
+			protocolRounds,
+			selfID,
+			ids,
+			hash.BytesWithDomain{TheDomain: "Rabin VSS DKG Threshold", Bytes: []byte{byte(threshold)}},
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("dkg_rabin.Start: %w", err)
+		}
+
+		secret := sample.Scalar(rand.Reader)
+		fPoly := polynomial.NewPolynomial(threshold, secret)
+		FSelf := polynomial.NewPolynomialExponent(fPoly)
+
+		return &round1{
+			Helper:         helper,
+			Threshold:      threshold,
+			IdentitySecret: identitySecret,
+			IdentityPublic: identityPublic,
+			fPoly:          fPoly,
+			FSelf:          FSelf,
+			VSSPolynomials: map[party.ID]*polynomial.Exponent{selfID: FSelf},
+			ShareReceived:  map[party.ID]*curve.Scalar{selfID: fPoly.Evaluate(selfID.Scalar())},
+			Complaints:     map[party.ID][]party.ID{},
+		}, helper, nil
+	}
+}
+
+// VerifyMessage implements round.Round. Nothing precedes round1, so there is nothing to verify.
+func (round1) VerifyMessage(round.Message) error { return nil }
+
+// StoreMessage implements round.Round. Nothing precedes round1, so there is nothing to store.
+func (round1) StoreMessage(round.Message) error { return nil }
+
+// Finalize implements round.Round.
+//
+//   - encrypt and send our share of fᵢ(X), together with Fᵢ(X) and a proof of knowledge of fᵢ(0),
+//     to every other party.
+func (r *round1) Finalize(out chan<- *round.Message) (round.Round, error) {
+	proof := zksch.Prove(r.Hash(), r.FSelf.Evaluate(curve.NewScalar()), r.fPoly.Evaluate(curve.NewScalar()))
+
+	for _, j := range r.OtherPartyIDs() {
+		share := r.fPoly.Evaluate(j.Scalar())
+		ciphertext, err := encryptShare(r.IdentitySecret, r.IdentityPublic[j], transcriptAD(r.Hash()), share)
+		if err != nil {
+			return r, err
+		}
+		if err := r.SendMessage(out, &message1{
+			VSSPolynomial: r.FSelf,
+			Proof:         proof,
+			Ciphertext:    ciphertext,
+		}, j); err != nil {
+			return r, err
+		}
+	}
+
+	return &round2{round1: r}, nil
+}
+
+// MessageContent implements round.Round.
+func (round1) MessageContent() round.Content { return &message1{} }
+
+// Number implements round.Round.
+func (round1) Number() round.Number { return 1 }
+
+// Init implements round.Content.
+func (m *message1) Init(curve.Curve) {}
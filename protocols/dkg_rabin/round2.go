@@ -0,0 +1,86 @@
+package dkg_rabin
+
+import (
+	"github.com/taurusgroup/multi-party-sig/internal/round"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/party"
+)
+
+type round2 struct {
+	*round1
+}
+
+type message2 struct {
+	// Complaints lists every dealer that the sender's decrypted share failed to validate against.
+	Complaints []party.ID
+}
+
+// VerifyMessage implements round.Round.
+//
+//   - verify the Schnorr proof of knowledge of fⱼ(0) against the published Fⱼ(X).
+func (r *round2) VerifyMessage(msg round.Message) error {
+	body, ok := msg.Content.(*message1)
+	if !ok || body == nil || body.VSSPolynomial == nil || body.Proof == nil {
+		return round.ErrInvalidContent
+	}
+	public := body.VSSPolynomial.Evaluate(curve.NewScalar())
+	if !body.Proof.Verify(r.HashForID(msg.From), public) {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+// StoreMessage implements round.Round.
+//
+// Unlike protocols/simplpedpop, a VSS mismatch here does not fail the round: it is instead recorded
+// as a complaint against the dealer, to be broadcast in Finalize and resolved in round3.
+//
+//   - decrypt our share of party j's polynomial.
+//   - if it matches the published commitment Fⱼ(i), store it; otherwise raise a complaint.
+func (r *round2) StoreMessage(msg round.Message) error {
+	from, body := msg.From, msg.Content.(*message1)
+	r.VSSPolynomials[from] = body.VSSPolynomial
+
+	share, err := decryptShare(r.IdentitySecret, r.IdentityPublic[from], transcriptAD(r.HashForID(from)), body.Ciphertext)
+	if err != nil {
+		r.Complaints[r.SelfID()] = append(r.Complaints[r.SelfID()], from)
+		return nil
+	}
+
+	expected := body.VSSPolynomial.Evaluate(r.SelfID().Scalar())
+	actual := curve.NewIdentityPoint().ScalarBaseMult(share)
+	if !actual.Equal(expected) {
+		r.Complaints[r.SelfID()] = append(r.Complaints[r.SelfID()], from)
+		return nil
+	}
+
+	r.ShareReceived[from] = share
+	return nil
+}
+
+// Finalize implements round.Round.
+//
+//   - broadcast every complaint we raised against a dealer whose share failed to validate, so that
+//     the accused dealer gets a chance to justify itself in round3.
+func (r *round2) Finalize(out chan<- *round.Message) (round.Round, error) {
+	if err := r.SendMessage(out, &message2{Complaints: r.Complaints[r.SelfID()]}, ""); err != nil {
+		return r, err
+	}
+
+	return &round3{
+		round2:             r,
+		ComplaintsReceived: map[party.ID][]party.ID{r.SelfID(): r.Complaints[r.SelfID()]},
+	}, nil
+}
+
+// MessageContent implements round.Round.
+func (round2) MessageContent() round.Content { return &message1{} }
+
+// Number implements round.Round.
+func (round2) Number() round.Number { return 2 }
+
+// PreviousRound implements round.Round.
+func (r *round2) PreviousRound() round.Round { return r.round1 }
+
+// Init implements round.Content.
+func (m *message2) Init(curve.Curve) {}
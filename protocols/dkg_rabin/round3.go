@@ -0,0 +1,87 @@
+package dkg_rabin
+
+import (
+	"github.com/taurusgroup/multi-party-sig/internal/round"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/party"
+)
+
+type round3 struct {
+	*round2
+
+	// ComplaintsReceived[i] = the dealers party i complained against, as broadcast in round2.
+	// Seeded with our own.
+	ComplaintsReceived map[party.ID][]party.ID
+}
+
+type justification struct {
+	// For is the complaining party this justification answers.
+	For party.ID
+	// Share = fⱼ(For), the sender's true share for party For, revealed in the clear.
+	Share *curve.Scalar
+}
+
+type message3 struct {
+	// Justifications, one per complaint the sender received against itself as dealer.
+	Justifications []justification
+}
+
+// VerifyMessage implements round.Round.
+func (round3) VerifyMessage(msg round.Message) error {
+	body, ok := msg.Content.(*message2)
+	if !ok || body == nil {
+		return round.ErrInvalidContent
+	}
+	return nil
+}
+
+// StoreMessage implements round.Round.
+func (r *round3) StoreMessage(msg round.Message) error {
+	r.ComplaintsReceived[msg.From] = msg.Content.(*message2).Complaints
+	return nil
+}
+
+// Finalize implements round.Round.
+//
+//   - for every complaint raised against us as dealer, reveal the complainant's true share in the
+//     clear, so every party can check it against our already-published commitment without needing
+//     our decryption key.
+func (r *round3) Finalize(out chan<- *round.Message) (round.Round, error) {
+	var justifications []justification
+	seen := map[party.ID]bool{}
+	for complainant, complaints := range r.ComplaintsReceived {
+		for _, against := range complaints {
+			if against != r.SelfID() || seen[complainant] {
+				continue
+			}
+			seen[complainant] = true
+			justifications = append(justifications, justification{
+				For:   complainant,
+				Share: r.fPoly.Evaluate(complainant.Scalar()),
+			})
+		}
+	}
+
+	if len(justifications) > 0 {
+		if err := r.SendMessage(out, &message3{Justifications: justifications}, ""); err != nil {
+			return r, err
+		}
+	}
+
+	return &output{
+		round3:         r,
+		Justifications: map[party.ID]map[party.ID]*curve.Scalar{},
+	}, nil
+}
+
+// MessageContent implements round.Round.
+func (round3) MessageContent() round.Content { return &message2{} }
+
+// Number implements round.Round.
+func (round3) Number() round.Number { return 3 }
+
+// PreviousRound implements round.Round.
+func (r *round3) PreviousRound() round.Round { return r.round2 }
+
+// Init implements round.Content.
+func (m *message3) Init(curve.Curve) {}
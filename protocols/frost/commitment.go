@@ -0,0 +1,61 @@
+package frost
+
+import (
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/party"
+)
+
+// CommitmentID identifies a single pre-committed nonce pair within one signer's Store. A
+// (ParticipantID, CommitmentID) pair is only ever handed out once by StartPreCommit, so a signer
+// that tracks which IDs it has consumed can never be made to reuse a nonce across two signatures.
+type CommitmentID uint32
+
+// Commitment is the public half of a one-time FROST nonce pair (Dᵢ, Eᵢ) = (dᵢ·G, eᵢ·G), published
+// by a signer during the pre-commit round. This is the (CommitmentID, ParticipantID, D, E) tuple
+// that a coordinator gathers into the list B for a signing session.
+type Commitment struct {
+	ID            CommitmentID
+	ParticipantID party.ID
+	D, E          *curve.Point
+}
+
+// nonceSecret holds the nonce scalars (dᵢ, eᵢ) backing a published Commitment.
+type nonceSecret struct {
+	d, e *curve.Scalar
+}
+
+// Store holds the nonce secrets generated by StartPreCommit until StartSign consumes them. A
+// Store must never be shared between two signing sessions that might take the same CommitmentID,
+// since Take deletes a secret as soon as it is handed out so it cannot be reused.
+type Store struct {
+	nextID  CommitmentID
+	secrets map[CommitmentID]*nonceSecret
+}
+
+// NewStore creates an empty commitment Store.
+func NewStore() *Store {
+	return &Store{
+		nextID:  1,
+		secrets: map[CommitmentID]*nonceSecret{},
+	}
+}
+
+// Take removes and returns the nonce secrets for id. It returns false if id is unknown, which
+// means either the CommitmentID was already consumed or it belongs to a different Store.
+func (s *Store) Take(id CommitmentID) (d, e *curve.Scalar, ok bool) {
+	secret, ok := s.secrets[id]
+	if !ok {
+		return nil, nil, false
+	}
+	delete(s.secrets, id)
+	return secret.d, secret.e, true
+}
+
+// add records a freshly generated nonce pair under the next available CommitmentID and returns
+// the public Commitment to broadcast.
+func (s *Store) add(self party.ID, d, e *curve.Scalar, D, E *curve.Point) Commitment {
+	id := s.nextID
+	s.nextID++
+	s.secrets[id] = &nonceSecret{d: d, e: e}
+	return Commitment{ID: id, ParticipantID: self, D: D, E: E}
+}
@@ -0,0 +1,23 @@
+package frost
+
+import (
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/party"
+)
+
+// Config is the secret-sharing material FROST needs to sign: a Feldman/Shamir sharing of a secret
+// key together with the individual public shares. It is satisfied both by *keygen.Config (the
+// output of the full CMP keygen) and by *simplpedpop.SchnorrConfig (the lighter Schnorr-only DKG),
+// so StartPreCommit and StartSign can run on top of either.
+type Config interface {
+	// PartyID returns our own party ID.
+	PartyID() party.ID
+	// SecretECDSA returns our own secret share xᵢ.
+	SecretECDSA() *curve.Scalar
+	// PublicECDSA returns the public share Xⱼ of party j.
+	PublicECDSA(j party.ID) *curve.Point
+	// CanSign reports whether signers is a valid signing subset.
+	CanSign(signers party.IDSlice) bool
+	// Validate checks the internal consistency of the config.
+	Validate() error
+}
@@ -0,0 +1,32 @@
+// Package frost implements FROST, a two-round Schnorr threshold signing protocol that runs on top
+// of the secret shares produced by keygen.Config — the same ECDSA scalar share and Shamir
+// structure the CMP protocols already use.
+//
+// Unlike cmp/sign, which produces an ECDSA signature and therefore needs Paillier/Pedersen
+// preprocessing to securely combine nonce shares, FROST signs with a plain Schnorr signature and
+// only works over curves where that is meaningful (Ed25519, or secp256k1 in BIP-340 mode).
+//
+// Signing is split into two independent phases:
+//
+//   - StartPreCommit lets every signer generate a batch of one-time nonce pairs (Dᵢ, Eᵢ) well
+//     ahead of time, broadcasting the public half and keeping the nonces themselves in a Store.
+//     Each published commitment is tagged with a CommitmentID so that it can be looked up and
+//     consumed exactly once.
+//   - StartSign consumes one previously-unused commitment per signer (chosen by whoever assembled
+//     the list B, typically a coordinator) and runs a single round to compute and combine the
+//     signature shares.
+package frost
+
+import (
+	"github.com/taurusgroup/multi-party-sig/pkg/protocol/types"
+)
+
+const (
+	// protocolPreCommitID identifies the nonce pre-commitment round.
+	protocolPreCommitID types.ProtocolID = "frost/pre-commit"
+	// protocolSignID identifies the two-round signing protocol.
+	protocolSignID types.ProtocolID = "frost/sign"
+
+	protocolPreCommitRounds types.RoundNumber = 1
+	protocolSignRounds      types.RoundNumber = 2
+)
@@ -0,0 +1,128 @@
+package frost
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"github.com/taurusgroup/multi-party-sig/internal/round"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/sample"
+	"github.com/taurusgroup/multi-party-sig/pkg/party"
+	"github.com/taurusgroup/multi-party-sig/pkg/protocol"
+)
+
+var _ round.Round = (*precommitRound1)(nil)
+
+// PreCommitResult is the output of StartPreCommit: the public Commitments published by every
+// signer for this run, keyed by ParticipantID. The matching nonce secrets stay in the Store that
+// was passed to StartPreCommit.
+type PreCommitResult struct {
+	Public map[party.ID][]Commitment
+}
+
+type precommitRound1 struct {
+	*round.Helper
+
+	Count int
+
+	// Own is the batch of commitments this party generated for this run.
+	Own []Commitment
+
+	// Received[j] = the batch of commitments published by party j.
+	Received map[party.ID][]Commitment
+}
+
+type precommitMessage1 struct {
+	Commitments []Commitment
+}
+
+// StartPreCommit generates count fresh one-time nonce pairs and broadcasts their public half. The
+// nonce secrets are kept in store, and can later be consumed by StartSign — at most once each — to
+// produce a signature share without needing another round of nonce generation.
+func StartPreCommit(config Config, signers []party.ID, count int, store *Store) protocol.StartFunc {
+	return func() (round.Round, protocol.Info, error) {
+		if count <= 0 {
+			return nil, nil, errors.New("frost.StartPreCommit: count must be positive")
+		}
+
+		selfID := config.PartyID()
+		partyIDs := party.NewIDSlice(signers)
+
+		helper, err := round.NewHelper(
+			protocolPreCommitID,
+			protocolPreCommitRounds,
+			selfID,
+			partyIDs,
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("frost.StartPreCommit: %w", err)
+		}
+
+		own := make([]Commitment, count)
+		for i := 0; i < count; i++ {
+			d := sample.Scalar(rand.Reader)
+			e := sample.Scalar(rand.Reader)
+			D := curve.NewIdentityPoint().ScalarBaseMult(d)
+			E := curve.NewIdentityPoint().ScalarBaseMult(e)
+			own[i] = store.add(selfID, d, e, D, E)
+		}
+
+		return &precommitRound1{
+			Helper:   helper,
+			Count:    count,
+			Own:      own,
+			Received: map[party.ID][]Commitment{selfID: own},
+		}, helper, nil
+	}
+}
+
+// VerifyMessage implements round.Round.
+func (r *precommitRound1) VerifyMessage(msg round.Message) error {
+	body, ok := msg.Content.(*precommitMessage1)
+	if !ok || body == nil {
+		return round.ErrInvalidContent
+	}
+	if len(body.Commitments) != r.Count {
+		return errors.New("frost: unexpected number of commitments")
+	}
+	for _, c := range body.Commitments {
+		if c.ParticipantID != msg.From {
+			return errors.New("frost: commitment is tagged with a different participant than the sender")
+		}
+	}
+	return nil
+}
+
+// StoreMessage implements round.Round.
+func (r *precommitRound1) StoreMessage(msg round.Message) error {
+	from, body := msg.From, msg.Content.(*precommitMessage1)
+	r.Received[from] = body.Commitments
+	return nil
+}
+
+// Finalize implements round.Round.
+func (r *precommitRound1) Finalize(out chan<- *round.Message) (round.Round, error) {
+	if err := r.SendMessage(out, &precommitMessage1{Commitments: r.Own}, ""); err != nil {
+		return r, err
+	}
+	return &round.Output{Result: &PreCommitResult{Public: r.Received}}, nil
+}
+
+// MessageContent implements round.Round.
+func (precommitRound1) MessageContent() round.Content { return &precommitMessage1{} }
+
+// Number implements round.Round.
+func (precommitRound1) Number() round.Number { return 1 }
+
+// Init implements round.Content.
+func (m *precommitMessage1) Init(curve.Curve) {}
+
+// BroadcastData implements broadcast.Broadcaster.
+func (m *precommitMessage1) BroadcastData() []byte {
+	buf := make([]byte, 0, len(m.Commitments)*4)
+	for _, c := range m.Commitments {
+		buf = append(buf, byte(c.ID), byte(c.ID>>8), byte(c.ID>>16), byte(c.ID>>24))
+	}
+	return buf
+}
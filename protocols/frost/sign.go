@@ -0,0 +1,250 @@
+package frost
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/taurusgroup/multi-party-sig/internal/hash"
+	"github.com/taurusgroup/multi-party-sig/internal/round"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/polynomial"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/sample"
+	"github.com/taurusgroup/multi-party-sig/pkg/party"
+	"github.com/taurusgroup/multi-party-sig/pkg/protocol"
+)
+
+var (
+	_ round.Round = (*signRound1)(nil)
+	_ round.Round = (*signOutput)(nil)
+)
+
+// Signature is a Schnorr signature (R, z) produced by FROST.
+type Signature struct {
+	R *curve.Point
+	Z *curve.Scalar
+}
+
+// Verify reports whether sig is a valid Schnorr signature on message under the aggregated public
+// key public, i.e. whether z·G == R + c·Y for c = H(R, Y, message).
+func (sig Signature) Verify(public *curve.Point, message []byte) bool {
+	c := challenge(sig.R, public, message)
+	lhs := curve.NewIdentityPoint().ScalarBaseMult(sig.Z)
+	rhs := curve.NewIdentityPoint().Add(sig.R, curve.NewIdentityPoint().ScalarMult(c, public))
+	return lhs.Equal(rhs)
+}
+
+// challenge computes c = H(R, Y, message), the Fiat-Shamir challenge binding a FROST signature to
+// the group commitment R, the aggregated public key Y, and the message being signed.
+func challenge(R, Y *curve.Point, message []byte) *curve.Scalar {
+	h := hash.New()
+	_ = h.WriteAny(hash.BytesWithDomain{TheDomain: "FROST Challenge", Bytes: message}, R, Y)
+	return sample.Scalar(h)
+}
+
+// bindingFactor computes ρᵢ = H("rho", i, msg, B) for signer i given the full commitment list B.
+func bindingFactor(id party.ID, message []byte, commitments []Commitment) *curve.Scalar {
+	h := hash.New()
+	_ = h.WriteAny(hash.BytesWithDomain{TheDomain: "FROST Binding Factor", Bytes: message}, id)
+	for _, c := range commitments {
+		_ = h.WriteAny(c.ParticipantID, c.D, c.E)
+	}
+	return sample.Scalar(h)
+}
+
+// groupCommitment computes R = Σⱼ (Dⱼ + ρⱼ·Eⱼ) and returns it along with every signer's ρⱼ.
+func groupCommitment(message []byte, commitments []Commitment) (*curve.Point, map[party.ID]*curve.Scalar) {
+	rho := make(map[party.ID]*curve.Scalar, len(commitments))
+	R := curve.NewIdentityPoint()
+	for _, c := range commitments {
+		rhoJ := bindingFactor(c.ParticipantID, message, commitments)
+		rho[c.ParticipantID] = rhoJ
+		Rj := curve.NewIdentityPoint().Add(c.D, curve.NewIdentityPoint().ScalarMult(rhoJ, c.E))
+		R.Add(R, Rj)
+	}
+	return R, rho
+}
+
+type signRound1 struct {
+	*round.Helper
+
+	Config      Config
+	Commitments []Commitment
+	Message     []byte
+
+	Lagrange map[party.ID]*curve.Scalar
+	PublicY  *curve.Point
+	R        *curve.Point
+	Rho      map[party.ID]*curve.Scalar
+
+	// Z[j] = the signature share zⱼ published by signer j.
+	Z map[party.ID]*curve.Scalar
+}
+
+type signMessage1 struct {
+	Z *curve.Scalar
+}
+
+// StartSign consumes the commitment id for the local signer out of store, and runs FROST's
+// signing round against commitments — one entry per participant in signers, previously assembled
+// by whoever coordinates this signing session. commitments must never be reused for a different
+// message.
+func StartSign(config Config, signers []party.ID, message []byte, commitments []Commitment, store *Store, id CommitmentID) protocol.StartFunc {
+	return func() (round.Round, protocol.Info, error) {
+		if len(message) == 0 {
+			return nil, nil, errors.New("frost.StartSign: message is nil")
+		}
+
+		signerIDs := party.NewIDSlice(signers)
+		if !config.CanSign(signerIDs) {
+			return nil, nil, errors.New("frost.StartSign: signers is not a valid signing subset")
+		}
+		if err := config.Validate(); err != nil {
+			return nil, nil, err
+		}
+		if len(commitments) != len(signers) {
+			return nil, nil, errors.New("frost.StartSign: commitments must contain one entry per signer")
+		}
+
+		selfID := config.PartyID()
+		d, e, ok := store.Take(id)
+		if !ok {
+			return nil, nil, fmt.Errorf("frost.StartSign: commitment %d was already used or is unknown", id)
+		}
+
+		helper, err := round.NewHelper(
+			protocolSignID,
+			protocolSignRounds,
+			selfID,
+			signerIDs,
+			hash.BytesWithDomain{TheDomain: "Signature Message", Bytes: message},
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("frost.StartSign: %w", err)
+		}
+
+		lagrange := polynomial.Lagrange(signers)
+
+		// Y = Σ λⱼ·Xⱼ, the same Lagrange-weighted aggregation used to scale our own secret share
+		// below. Summing the raw, unscaled shares Xⱼ instead would make the challenge c bind to the
+		// wrong point whenever any λⱼ != 1, i.e. for any generic signing subset.
+		Y := curve.NewIdentityPoint()
+		for _, j := range signerIDs {
+			Y.Add(Y, curve.NewIdentityPoint().ScalarMult(lagrange[j], config.PublicECDSA(j)))
+		}
+
+		R, rho := groupCommitment(message, commitments)
+		c := challenge(R, Y, message)
+
+		// zᵢ = dᵢ + ρᵢ·eᵢ + λᵢ·xᵢ·c
+		z := curve.NewScalar().Set(d)
+		rhoE := curve.NewScalar().Multiply(rho[selfID], e)
+		z.Add(z, rhoE)
+		lambdaXC := curve.NewScalar().Multiply(lagrange[selfID], config.SecretECDSA())
+		lambdaXC.Multiply(lambdaXC, c)
+		z.Add(z, lambdaXC)
+
+		return &signRound1{
+			Helper:      helper,
+			Config:      config,
+			Commitments: commitments,
+			Message:     message,
+			Lagrange:    lagrange,
+			PublicY:     Y,
+			R:           R,
+			Rho:         rho,
+			Z:           map[party.ID]*curve.Scalar{selfID: z},
+		}, helper, nil
+	}
+}
+
+// VerifyMessage implements round.Round.
+func (r *signRound1) VerifyMessage(msg round.Message) error {
+	body, ok := msg.Content.(*signMessage1)
+	if !ok || body == nil || body.Z == nil {
+		return round.ErrInvalidContent
+	}
+	return nil
+}
+
+// StoreMessage implements round.Round.
+func (r *signRound1) StoreMessage(msg round.Message) error {
+	from, body := msg.From, msg.Content.(*signMessage1)
+	r.Z[from] = body.Z
+	return nil
+}
+
+// Finalize implements round.Round.
+//
+// - broadcast our own signature share zᵢ.
+func (r *signRound1) Finalize(out chan<- *round.Message) (round.Round, error) {
+	if err := r.SendMessage(out, &signMessage1{Z: r.Z[r.SelfID()]}, ""); err != nil {
+		return r, err
+	}
+	return &signOutput{signRound1: r}, nil
+}
+
+// MessageContent implements round.Round.
+func (signRound1) MessageContent() round.Content { return &signMessage1{} }
+
+// Number implements round.Round.
+func (signRound1) Number() round.Number { return 1 }
+
+// Init implements round.Content.
+func (m *signMessage1) Init(curve.Curve) {}
+
+type signOutput struct {
+	*signRound1
+}
+
+// VerifyMessage implements round.Round.
+func (signOutput) VerifyMessage(round.Message) error { return nil }
+
+// StoreMessage implements round.Round.
+func (signOutput) StoreMessage(round.Message) error { return nil }
+
+// Finalize implements round.Round.
+//
+// - verify every signer's share against their individual commitment Rⱼ and public share Yⱼ.
+// - sum the shares to recover z, and output the aggregated Schnorr signature (R, z).
+func (r *signOutput) Finalize(chan<- *round.Message) (round.Round, error) {
+	c := challenge(r.R, r.PublicY, r.Message)
+
+	z := curve.NewScalar()
+	for _, j := range r.PartyIDs() {
+		zj, ok := r.Z[j]
+		if !ok {
+			return nil, fmt.Errorf("frost: missing signature share from %v", j)
+		}
+
+		var Rj *curve.Point
+		for _, comm := range r.Commitments {
+			if comm.ParticipantID == j {
+				Rj = curve.NewIdentityPoint().Add(comm.D, curve.NewIdentityPoint().ScalarMult(r.Rho[j], comm.E))
+				break
+			}
+		}
+		if Rj == nil {
+			return nil, fmt.Errorf("frost: no commitment for %v", j)
+		}
+
+		lambdaC := curve.NewScalar().Multiply(r.Lagrange[j], c)
+		lhs := curve.NewIdentityPoint().ScalarBaseMult(zj)
+		rhs := curve.NewIdentityPoint().Add(Rj, curve.NewIdentityPoint().ScalarMult(lambdaC, r.Config.PublicECDSA(j)))
+		if !lhs.Equal(rhs) {
+			return nil, fmt.Errorf("frost: invalid signature share from %v", j)
+		}
+
+		z.Add(z, zj)
+	}
+
+	return &round.Output{Result: &Signature{R: r.R, Z: z}}, nil
+}
+
+// MessageContent implements round.Round.
+func (signOutput) MessageContent() round.Content { return &signMessage1{} }
+
+// Number implements round.Round.
+func (signOutput) Number() round.Number { return 2 }
+
+// PreviousRound implements round.Round.
+func (r *signOutput) PreviousRound() round.Round { return r.signRound1 }
@@ -0,0 +1,98 @@
+package frost
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/polynomial"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/sample"
+	"github.com/taurusgroup/multi-party-sig/pkg/party"
+	"github.com/taurusgroup/multi-party-sig/protocols/simplpedpop"
+)
+
+// shamirConfigs builds threshold-of-len(ids) SchnorrConfigs for ids, sharing a single fresh
+// secret key, the same shape simplpedpop's DKG would hand back to protocols/frost.
+func shamirConfigs(ids []party.ID, threshold int) (map[party.ID]*simplpedpop.SchnorrConfig, *curve.Point) {
+	poly := polynomial.NewPolynomial(threshold, sample.Scalar(rand.Reader))
+	publicKey := curve.NewIdentityPoint().ScalarBaseMult(poly.Evaluate(curve.NewScalar()))
+
+	public := make(map[party.ID]*simplpedpop.Public, len(ids))
+	shares := make(map[party.ID]*curve.Scalar, len(ids))
+	for _, id := range ids {
+		share := poly.Evaluate(id.Scalar())
+		shares[id] = share
+		public[id] = &simplpedpop.Public{ECDSA: curve.NewIdentityPoint().ScalarBaseMult(share)}
+	}
+
+	configs := make(map[party.ID]*simplpedpop.SchnorrConfig, len(ids))
+	for _, id := range ids {
+		configs[id] = &simplpedpop.SchnorrConfig{
+			ID:        id,
+			Threshold: threshold,
+			Public:    public,
+			PublicKey: publicKey,
+			ECDSA:     shares[id],
+		}
+	}
+	return configs, publicKey
+}
+
+// TestFrostSignRoundTrip runs StartSign for every signer of a (2-of-3) Shamir-shared key, with
+// nonce commitments added directly to each signer's Store instead of through StartPreCommit, sums
+// the signature shares by hand, and checks that the resulting Signature verifies against the real
+// aggregated group key. This
+// would have caught StartSign deriving the Fiat-Shamir challenge from the unweighted sum of raw
+// public shares instead of Σ λⱼ·Xⱼ.
+func TestFrostSignRoundTrip(t *testing.T) {
+	ids := []party.ID{"1", "2", "3"}
+	threshold := 1
+	signers := ids[:2] // a proper subset, so every Lagrange coefficient here is != 1.
+
+	configs, publicKey := shamirConfigs(ids, threshold)
+
+	stores := make(map[party.ID]*Store, len(signers))
+	commitments := make([]Commitment, 0, len(signers))
+	for _, id := range signers {
+		store := NewStore()
+		d, e := sample.Scalar(rand.Reader), sample.Scalar(rand.Reader)
+		D := curve.NewIdentityPoint().ScalarBaseMult(d)
+		E := curve.NewIdentityPoint().ScalarBaseMult(e)
+		commitments = append(commitments, store.add(id, d, e, D, E))
+		stores[id] = store
+	}
+
+	message := []byte("frost round-trip test message")
+
+	shares := make(map[party.ID]*curve.Scalar, len(signers))
+	var groupY *curve.Point
+	var R *curve.Point
+	for _, id := range signers {
+		startedRound, _, err := StartSign(configs[id], signers, message, commitments, stores[id], 1)()
+		if err != nil {
+			t.Fatalf("StartSign(%v): %v", id, err)
+		}
+		r1 := startedRound.(*signRound1)
+		shares[id] = r1.Z[id]
+		if groupY == nil {
+			groupY = r1.PublicY
+			R = r1.R
+		} else if !groupY.Equal(r1.PublicY) {
+			t.Fatalf("signers disagree on the aggregated public key Y")
+		}
+	}
+
+	if !groupY.Equal(publicKey) {
+		t.Fatal("Lagrange-weighted Y does not match the real aggregated public key")
+	}
+
+	z := curve.NewScalar()
+	for _, id := range signers {
+		z.Add(z, shares[id])
+	}
+	sig := Signature{R: R, Z: z}
+
+	if !sig.Verify(publicKey, message) {
+		t.Fatal("aggregated signature failed to verify against the real group public key")
+	}
+}
@@ -0,0 +1,69 @@
+package simplpedpop
+
+import (
+	"errors"
+
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/party"
+)
+
+// Public holds the public Schnorr share for a single party, as produced by this DKG.
+type Public struct {
+	// ECDSA = Xⱼ = F(j), this party's public key share.
+	ECDSA *curve.Point
+}
+
+// SchnorrConfig is the result of running this DKG: a Feldman-VSS sharing of a fresh secret key,
+// without any of the Paillier or Pedersen parameters that protocols/cmp/keygen additionally
+// prepares for CMP signing. It is intended for Schnorr-only consumers such as protocols/frost.
+type SchnorrConfig struct {
+	// ID is our own party ID.
+	ID party.ID
+	// Threshold is the minimum number of parties needed to reconstruct or use the secret.
+	Threshold int
+	// Public[j] is the public share of party j.
+	Public map[party.ID]*Public
+	// PublicKey = Y, the aggregated group public key.
+	PublicKey *curve.Point
+	// ECDSA = xᵢ, our own secret share.
+	ECDSA *curve.Scalar
+}
+
+// PartyID implements frost.Config.
+func (c *SchnorrConfig) PartyID() party.ID { return c.ID }
+
+// SecretECDSA implements frost.Config.
+func (c *SchnorrConfig) SecretECDSA() *curve.Scalar { return c.ECDSA }
+
+// PublicECDSA implements frost.Config.
+func (c *SchnorrConfig) PublicECDSA(id party.ID) *curve.Point { return c.Public[id].ECDSA }
+
+// CanSign implements frost.Config.
+//
+// signers can reconstruct the secret key if and only if there are strictly more than Threshold of
+// them, and each of them is a party this Config knows a public share for.
+func (c *SchnorrConfig) CanSign(signers party.IDSlice) bool {
+	if len(signers) <= c.Threshold {
+		return false
+	}
+	if !signers.Contains(c.ID) {
+		return false
+	}
+	for _, j := range signers {
+		if _, ok := c.Public[j]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate implements frost.Config.
+func (c *SchnorrConfig) Validate() error {
+	if c == nil || c.PublicKey == nil || c.ECDSA == nil {
+		return errors.New("simplpedpop: config is invalid")
+	}
+	if _, ok := c.Public[c.ID]; !ok {
+		return errors.New("simplpedpop: config does not contain our own public share")
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package simplpedpop
+
+import (
+	"github.com/taurusgroup/multi-party-sig/internal/round"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/party"
+)
+
+type output struct {
+	*round1
+}
+
+// VerifyMessage implements round.Round.
+//
+// - verify the Schnorr proof of knowledge of fⱼ(0) against the published Fⱼ(X).
+func (r *output) VerifyMessage(msg round.Message) error {
+	body, ok := msg.Content.(*message1)
+	if !ok || body == nil || body.VSSPolynomial == nil || body.Proof == nil {
+		return round.ErrInvalidContent
+	}
+	public := body.VSSPolynomial.Evaluate(curve.NewScalar())
+	if !body.Proof.Verify(r.HashForID(msg.From), public) {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+// StoreMessage implements round.Round.
+//
+// Since the ciphertext is addressed only to us, the VSS check happens here.
+// - decrypt our share of party j's polynomial.
+// - check that it matches the published commitment Fⱼ(i).
+func (r *output) StoreMessage(msg round.Message) error {
+	from, body := msg.From, msg.Content.(*message1)
+
+	share, err := decryptShare(r.IdentitySecret, r.IdentityPublic[from], transcriptAD(r.HashForID(from)), body.Ciphertext)
+	if err != nil {
+		return err
+	}
+
+	expected := body.VSSPolynomial.Evaluate(r.SelfID().Scalar())
+	actual := curve.NewIdentityPoint().ScalarBaseMult(share)
+	if !actual.Equal(expected) {
+		return ErrInvalidVSS
+	}
+
+	r.VSSPolynomials[from] = body.VSSPolynomial
+	r.ShareReceived[from] = share
+	return nil
+}
+
+// Finalize implements round.Round.
+//
+// - sum every share received into our own xᵢ = Σⱼ fⱼ(i).
+// - sum every constant term into the group public key Y = Σⱼ Fⱼ(0).
+// - recompute every party's public share Xⱼ = Σᵢ Fᵢ(j).
+func (r *output) Finalize(chan<- *round.Message) (round.Round, error) {
+	x := curve.NewScalar()
+	Y := curve.NewIdentityPoint()
+	for _, j := range r.PartyIDs() {
+		x.Add(x, r.ShareReceived[j])
+		Y.Add(Y, r.VSSPolynomials[j].Evaluate(curve.NewScalar()))
+	}
+
+	Public := make(map[party.ID]*Public, len(r.PartyIDs()))
+	for _, j := range r.PartyIDs() {
+		Xj := curve.NewIdentityPoint()
+		for _, k := range r.PartyIDs() {
+			Xj.Add(Xj, r.VSSPolynomials[k].Evaluate(j.Scalar()))
+		}
+		Public[j] = &Public{ECDSA: Xj}
+	}
+
+	config := &SchnorrConfig{
+		ID:        r.SelfID(),
+		Threshold: r.Threshold,
+		Public:    Public,
+		PublicKey: Y,
+		ECDSA:     x,
+	}
+
+	return &round.Output{Result: config}, nil
+}
+
+// MessageContent implements round.Round.
+func (output) MessageContent() round.Content { return &message1{} }
+
+// Number implements round.Round.
+func (output) Number() round.Number { return 2 }
+
+// PreviousRound implements round.Round.
+func (r *output) PreviousRound() round.Round { return r.round1 }
@@ -0,0 +1,140 @@
+package simplpedpop
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"github.com/taurusgroup/multi-party-sig/internal/hash"
+	"github.com/taurusgroup/multi-party-sig/internal/round"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/curve"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/polynomial"
+	"github.com/taurusgroup/multi-party-sig/pkg/math/sample"
+	"github.com/taurusgroup/multi-party-sig/pkg/party"
+	"github.com/taurusgroup/multi-party-sig/pkg/protocol"
+	zksch "github.com/taurusgroup/multi-party-sig/pkg/zk/sch"
+)
+
+var (
+	_ round.Round = (*round1)(nil)
+	_ round.Round = (*output)(nil)
+)
+
+var (
+	ErrInvalidProof = errors.New("simplpedpop: Schnorr proof of knowledge is invalid")
+	ErrInvalidVSS   = errors.New("simplpedpop: decrypted share does not match published commitment")
+)
+
+type round1 struct {
+	*round.Helper
+
+	Threshold int
+
+	// IdentitySecret is our long-term ECDH secret, used to derive the per-pair AEAD key that
+	// protects the share we send to every other party.
+	IdentitySecret *curve.Scalar
+	// IdentityPublic[j] is party j's long-term ECDH public key.
+	IdentityPublic map[party.ID]*curve.Point
+
+	// fPoly = fᵢ(X), our own degree-Threshold polynomial.
+	fPoly *polynomial.Polynomial
+	// FSelf = Fᵢ(X) = fᵢ(X)·G, the public commitment to fPoly.
+	FSelf *polynomial.Exponent
+
+	// VSSPolynomials[j] = Fⱼ(X), as published by party j. Seeded with our own.
+	VSSPolynomials map[party.ID]*polynomial.Exponent
+	// ShareReceived[j] = fⱼ(i), our share of party j's polynomial. Seeded with our own.
+	ShareReceived map[party.ID]*curve.Scalar
+}
+
+type message1 struct {
+	// VSSPolynomial = Fⱼ(X), the same for every recipient.
+	VSSPolynomial *polynomial.Exponent
+	// Proof is a Schnorr proof of knowledge of fⱼ(0), the same for every recipient.
+	Proof *zksch.Proof
+	// Ciphertext = Enc(fⱼ(i)), addressed to this message's specific recipient.
+	Ciphertext []byte
+}
+
+// Start runs this DKG among partyIDs, with the given threshold and long-term identity keys used to
+// derive the per-pair encryption keys that protect each party's share. identityPublic must contain
+// an entry for every party in partyIDs, including selfID.
+func Start(selfID party.ID, partyIDs []party.ID, threshold int, identitySecret *curve.Scalar, identityPublic map[party.ID]*curve.Point) protocol.StartFunc {
+	return func() (round.Round, protocol.Info, error) {
+		ids := party.NewIDSlice(partyIDs)
+		if threshold < 1 || threshold >= len(ids) {
+			return nil, nil, fmt.Errorf("simplpedpop.Start: invalid threshold %d for %d parties", threshold, len(ids))
+		}
+		for _, j := range ids {
+			if _, ok := identityPublic[j]; !ok {
+				return nil, nil, fmt.Errorf("simplpedpop.Start: missing identity key for %v", j)
+			}
+		}
+
+		helper, err := round.NewHelper(
+			protocolID,
+			protocolRounds,
+			selfID,
+			ids,
+			hash.BytesWithDomain{TheDomain: "SimplPedPoP Threshold", Bytes: []byte{byte(threshold)}},
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("simplpedpop.Start: %w", err)
+		}
+
+		secret := sample.Scalar(rand.Reader)
+		fPoly := polynomial.NewPolynomial(threshold, secret)
+		FSelf := polynomial.NewPolynomialExponent(fPoly)
+
+		return &round1{
+			Helper:         helper,
+			Threshold:      threshold,
+			IdentitySecret: identitySecret,
+			IdentityPublic: identityPublic,
+			fPoly:          fPoly,
+			FSelf:          FSelf,
+			VSSPolynomials: map[party.ID]*polynomial.Exponent{selfID: FSelf},
+			ShareReceived:  map[party.ID]*curve.Scalar{selfID: fPoly.Evaluate(selfID.Scalar())},
+		}, helper, nil
+	}
+}
+
+// VerifyMessage implements round.Round. Nothing precedes round1, so there is nothing to verify.
+func (round1) VerifyMessage(round.Message) error { return nil }
+
+// StoreMessage implements round.Round. Nothing precedes round1, so there is nothing to store.
+func (round1) StoreMessage(round.Message) error { return nil }
+
+// Finalize implements round.Round.
+//
+//   - encrypt and send our share of fᵢ(X), together with Fᵢ(X) and a proof of knowledge of fᵢ(0),
+//     to every other party.
+func (r *round1) Finalize(out chan<- *round.Message) (round.Round, error) {
+	proof := zksch.Prove(r.Hash(), r.FSelf.Evaluate(curve.NewScalar()), r.fPoly.Evaluate(curve.NewScalar()))
+
+	for _, j := range r.OtherPartyIDs() {
+		share := r.fPoly.Evaluate(j.Scalar())
+		ciphertext, err := encryptShare(r.IdentitySecret, r.IdentityPublic[j], transcriptAD(r.Hash()), share)
+		if err != nil {
+			return r, err
+		}
+		if err := r.SendMessage(out, &message1{
+			VSSPolynomial: r.FSelf,
+			Proof:         proof,
+			Ciphertext:    ciphertext,
+		}, j); err != nil {
+			return r, err
+		}
+	}
+
+	return &output{round1: r}, nil
+}
+
+// MessageContent implements round.Round.
+func (round1) MessageContent() round.Content { return &message1{} }
+
+// Number implements round.Round.
+func (round1) Number() round.Number { return 1 }
+
+// Init implements round.Content.
+func (m *message1) Init(curve.Curve) {}
@@ -0,0 +1,21 @@
+// Package simplpedpop implements a streamlined, SimplPedPoP-style distributed key generation
+// protocol for pure Schnorr/EdDSA threshold keys.
+//
+// Unlike cmp/keygen, which needs five rounds to additionally set up the Paillier and Pedersen
+// parameters required by the CMP signing protocol, simplpedpop produces a Feldman-VSS secret
+// sharing of a fresh key in a single round of broadcast plus one finalization step: every
+// participant samples a degree-t polynomial, broadcasts its Feldman commitment together with a
+// Schnorr proof of knowledge of the constant term, and privately hands every other participant its
+// evaluation of that polynomial, encrypted under a per-pair ECDH key derived from long-term
+// identity keys. This gives callers that only need a Schnorr-style key (e.g. for protocols/frost)
+// a much cheaper DKG path than the full CMP keygen.
+package simplpedpop
+
+import (
+	"github.com/taurusgroup/multi-party-sig/pkg/protocol/types"
+)
+
+const (
+	protocolID     types.ProtocolID  = "simplpedpop/keygen"
+	protocolRounds types.RoundNumber = 2
+)